@@ -0,0 +1,165 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/yeefea/gointerface/parser"
+)
+
+// directiveJob is the generation of the types annotated with the same directive in a package.
+type directiveJob struct {
+	file  string // file of the first directive, for the errors
+	line  int
+	args  []string
+	types []string
+}
+
+// generateDirectives runs gointerface generate: the packages of args are loaded once, and
+// the types annotated with //gointerface:generate or //go:generate gointerface are
+// generated with the flags of their directives.
+func generateDirectives(args []string) {
+	fs := flag.NewFlagSet("gointerface generate", flag.ExitOnError)
+	fs.BoolVar(&check, "check", false, "Check that the output files are up to date instead of writing them. The differences are printed and the program exits with 1.")
+	buildFlags(fs)
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: gointerface generate [flags] [packages]")
+		fmt.Fprintln(fs.Output(), "Generates the interfaces of the types annotated with //gointerface:generate [flags]. The packages default to the current directory.")
+		fs.PrintDefaults()
+	}
+	fs.Parse(args)
+	checkBuildFlags()
+
+	patterns := fs.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+	pkgs, err := expandInputs(patterns)
+	if err != nil {
+		fatal(err)
+	}
+	for _, pkg := range pkgs {
+		// the private methods are left out per directive
+		private = true
+		files := load(pkg)
+		for _, job := range directiveJobs(files) {
+			runDirective(pkg, files, job)
+		}
+	}
+}
+
+// directiveJobs groups the annotated types of files by directive, in the order of the sources.
+func directiveJobs(files []*parser.SourceFileInfo) []*directiveJob {
+	var jobs []*directiveJob
+	byArgs := map[string]*directiveJob{}
+	for _, f := range files {
+		for _, t := range f.Types {
+			for _, d := range t.Directives {
+				key := strings.Join(d.Args, "\x00")
+				job, ok := byArgs[key]
+				if !ok {
+					job = &directiveJob{file: f.FileName, line: d.Line, args: d.Args}
+					byArgs[key] = job
+					jobs = append(jobs, job)
+				}
+				job.types = append(job.types, t.Identifier)
+			}
+		}
+	}
+	return jobs
+}
+
+// written holds the files written by the directives, two directives writing the same
+// file would overwrite each other.
+var written = map[string]string{}
+
+// declared holds the interfaces generated by the directives of each package, the files
+// of two directives cannot declare the same interface.
+var declared = map[string]string{}
+
+// runDirective generates the interfaces of job. The flags of the directive are parsed
+// into the generation flags, -i is ignored since the input is the annotated package.
+func runDirective(pkg *packageInput, files []*parser.SourceFileInfo, job *directiveJob) {
+	where := fmt.Sprintf("%s:%d", job.file, job.line)
+	fs := flag.NewFlagSet(where, flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	generationFlags(fs)
+	var ignored inputList
+	fs.Var(&ignored, "i", "")
+	if err := fs.Parse(job.args); err != nil {
+		fatal(fmt.Errorf("%s: %w", where, err))
+	}
+	if fs.NArg() != 0 {
+		fatal(fmt.Errorf("%s: unexpected arguments %s", where, strings.Join(fs.Args(), " ")))
+	}
+
+	gen, err := newGenerator()
+	if err != nil {
+		fatal(fmt.Errorf("%s: %w", where, err))
+	}
+	if gen.Types == nil {
+		gen.Types = map[string]struct{}{}
+	}
+	for _, t := range job.types {
+		gen.Types[t] = struct{}{}
+	}
+	gen.Files = files
+	if !private {
+		gen.Files = publicMethods(files)
+	}
+
+	output := directiveOutput(pkg, outputFile, defaultOutputFile, where)
+	mockOutput := ""
+	if mockFile != "" {
+		mockOutput = directiveOutput(pkg, mockFile, "", where)
+	}
+	setOutputs(gen, output, mockOutput)
+	names, err := gen.InterfaceNames()
+	if err != nil {
+		fatal(fmt.Errorf("%s: %w", where, err))
+	}
+	for _, name := range names {
+		key := pkg.Dir + "\x00" + name
+		if other, ok := declared[key]; ok {
+			fatal(fmt.Errorf("%s: interface %s is already generated by the directive at %s", where, name, other))
+		}
+		declared[key] = where
+	}
+	generate(gen, output, mockOutput)
+}
+
+// directiveOutput returns the path of the output name of a directive, relative to the
+// directory of the package, and reports the outputs shared by several directives.
+func directiveOutput(pkg *packageInput, name, defaultName, where string) string {
+	if name == "" {
+		name = defaultName
+	}
+	if !filepath.IsAbs(name) {
+		name = filepath.Join(pkg.Dir, name)
+	}
+	if other, ok := written[name]; ok {
+		fatal(fmt.Errorf("%s: %s is already written by the directive at %s", where, name, other))
+	}
+	written[name] = where
+	return name
+}
+
+// publicMethods returns files without their private methods, the files are not modified.
+func publicMethods(files []*parser.SourceFileInfo) []*parser.SourceFileInfo {
+	result := make([]*parser.SourceFileInfo, 0, len(files))
+	for _, f := range files {
+		copied := *f
+		copied.Methods = nil
+		for _, m := range f.Methods {
+			if !unicode.IsLower(rune(m.Identifier[0])) {
+				copied.Methods = append(copied.Methods, m)
+			}
+		}
+		result = append(result, &copied)
+	}
+	return result
+}
@@ -1,5 +1,11 @@
 module github.com/yeefea/gointerface
 
-go 1.16
+go 1.23.0
 
-require github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20220418222510-f25a4f6275ed
+require (
+	github.com/antlr/antlr4/runtime/Go/antlr v0.0.0-20220418222510-f25a4f6275ed
+	golang.org/x/mod v0.27.0
+	golang.org/x/tools v0.36.0
+)
+
+require golang.org/x/sync v0.16.0 // indirect
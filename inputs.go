@@ -0,0 +1,275 @@
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"io/fs"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// inputList is the value of the repeatable -i flag.
+type inputList []string
+
+func (l *inputList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *inputList) Set(v string) error {
+	*l = append(*l, v)
+	return nil
+}
+
+// packageInput is a package to extract the interfaces from. Files lists the Go files of
+// the package, Single is set if the input named a file rather than the directory.
+// ImportPath is set if the package is given by its import path.
+type packageInput struct {
+	Dir        string
+	Files      []string
+	Single     bool
+	ImportPath string
+}
+
+// expandInputs resolves the inputs to packages. An input is a Go file, a directory or a
+// directory followed by /... to include its subdirectories.
+func expandInputs(inputs []string) ([]*packageInput, error) {
+	var pkgs []*packageInput
+	seen := map[string]struct{}{}
+	add := func(pkg *packageInput) {
+		key := pkg.Dir
+		if pkg.Single {
+			key = pkg.Files[0]
+		}
+		if _, ok := seen[key]; ok {
+			return
+		}
+		seen[key] = struct{}{}
+		pkgs = append(pkgs, pkg)
+	}
+
+	for _, input := range inputs {
+		if root, ok := recursivePattern(input); ok {
+			dirs, err := walkPackages(root)
+			if err != nil {
+				return nil, err
+			}
+			if len(dirs) == 0 {
+				return nil, fmt.Errorf("no Go files in %s", input)
+			}
+			for _, dir := range dirs {
+				files, err := packageFiles(dir)
+				if err != nil {
+					return nil, err
+				}
+				add(&packageInput{Dir: dir, Files: files})
+			}
+			continue
+		}
+
+		info, err := os.Stat(input)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() { // is go file
+			add(&packageInput{Dir: filepath.Dir(input), Files: []string{input}, Single: true})
+			continue
+		}
+		files, err := packageFiles(input)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("no Go files in %s", input)
+		}
+		add(&packageInput{Dir: input, Files: files})
+	}
+	return pkgs, nil
+}
+
+// importedPackage returns the package of an import path, e.g. net/http, found by modules.
+func importedPackage(importPath string) (*packageInput, error) {
+	dir, err := modules.PackageDir(importPath)
+	if err != nil {
+		return nil, err
+	}
+	files, err := packageFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no Go files in package %s (%s)", importPath, dir)
+	}
+	return &packageInput{Dir: dir, Files: files, ImportPath: importPath}, nil
+}
+
+// recursivePattern reports whether input is of the form dir/..., and returns dir.
+func recursivePattern(input string) (string, bool) {
+	if input == "..." {
+		return ".", true
+	}
+	if !strings.HasSuffix(input, "/...") {
+		return "", false
+	}
+	root := strings.TrimSuffix(input, "/...")
+	if root == "" {
+		root = "/"
+	}
+	return root, true
+}
+
+// walkPackages returns the directories under root that contain Go files. Like the go
+// command, vendor and testdata directories and the ones starting with . or _ are skipped.
+func walkPackages(root string) ([]string, error) {
+	var dirs []string
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		if path != root {
+			name := d.Name()
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+		}
+		files, err := packageFiles(path)
+		if err != nil {
+			return err
+		}
+		if len(files) != 0 {
+			dirs = append(dirs, path)
+		}
+		return nil
+	})
+	sort.Strings(dirs)
+	return dirs, err
+}
+
+// platform is a GOOS/GOARCH pair, empty fields stand for the default of the go command.
+type platform struct {
+	GOOS   string
+	GOARCH string
+}
+
+func (p platform) String() string {
+	ctx := buildContext(p)
+	return ctx.GOOS + "/" + ctx.GOARCH
+}
+
+// parsePlatforms parses a list of GOOS/GOARCH pairs separated by comma, e.g. linux/amd64,windows/amd64.
+func parsePlatforms(s string) ([]platform, error) {
+	var result []platform
+	for _, p := range strings.Split(s, ",") {
+		parts := strings.Split(p, "/")
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf("invalid platform %q, expected GOOS/GOARCH", p)
+		}
+		result = append(result, platform{GOOS: parts[0], GOARCH: parts[1]})
+	}
+	return result, nil
+}
+
+// targetPlatforms returns the platforms of -platforms, or the one of -goos and -goarch.
+func targetPlatforms() []platform {
+	if len(platforms) != 0 {
+		return platforms
+	}
+	return []platform{{GOOS: goos, GOARCH: goarch}}
+}
+
+// buildContext returns the build context of p and -tags.
+func buildContext(p platform) *build.Context {
+	ctx := build.Default
+	if buildTags != "" {
+		ctx.BuildTags = strings.Split(buildTags, ",")
+	}
+	if p.GOOS != "" {
+		ctx.GOOS = p.GOOS
+	}
+	if p.GOARCH != "" {
+		ctx.GOARCH = p.GOARCH
+	}
+	return &ctx
+}
+
+// packageFiles returns the Go files of dir on any of the target platforms, sorted by name.
+func packageFiles(dir string) ([]string, error) {
+	seen := map[string]struct{}{}
+	var files []string
+	for _, p := range targetPlatforms() {
+		platformFiles, err := goFiles(dir, p)
+		if err != nil {
+			return nil, err
+		}
+		for _, f := range platformFiles {
+			if _, ok := seen[f]; !ok {
+				seen[f] = struct{}{}
+				files = append(files, f)
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// goFiles returns the Go files of dir on p sorted by name. Files excluded by build constraints
+// or by their GOOS/GOARCH suffix are left out, and so are test files unless -tests is set.
+func goFiles(dir string, p platform) ([]string, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	// sort files by name
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name() < entries[j].Name() })
+
+	var files []string
+	for _, f := range entries {
+		if f.IsDir() {
+			continue
+		}
+		if filepath.Ext(f.Name()) != ".go" {
+			continue
+		}
+		if strings.HasSuffix(f.Name(), "_test.go") && !tests {
+			continue
+		}
+		match, err := buildContext(p).MatchFile(dir, f.Name())
+		if err != nil {
+			return nil, err
+		}
+		if !match {
+			continue
+		}
+		files = append(files, filepath.Join(dir, f.Name()))
+	}
+	return files, nil
+}
+
+// outputPath returns where the output of a package is written when several packages are
+// generated: name in the directory of the package, or mirrored under outputRoot. The
+// directories under outputRoot are created when the file is written.
+func outputPath(name string, pkg *packageInput) (string, error) {
+	if outputRoot == "" {
+		return filepath.Join(pkg.Dir, name), nil
+	}
+	rel := pkg.Dir
+	if filepath.IsAbs(rel) {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		if rel, err = filepath.Rel(wd, pkg.Dir); err != nil {
+			return "", err
+		}
+	}
+	rel = filepath.Clean(rel)
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("cannot mirror %s under %s, it is outside of the working directory", pkg.Dir, outputRoot)
+	}
+	return filepath.Join(outputRoot, rel, name), nil
+}
@@ -1,13 +1,16 @@
 package main
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"sort"
+	"runtime"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/yeefea/gointerface/parser"
 
@@ -15,125 +18,615 @@ import (
 )
 
 var (
-	inputFile     string
+	inputs        inputList
+	importPkg     string
 	outputFile    string
+	outputRoot    string
+	inPlace       bool
+	check         bool
 	types         string
 	pkgName       string
 	private       bool
+	promoted      bool
+	receivers     string
+	mock          bool
+	assertions    bool
+	mockFile      string
+	mockPkgName   string
+	skipErrors    bool
+	skipBodies    bool
+	jobs          int
+	backend       string
+	nameTemplate  string
+	buildTags     string
+	goos          string
+	goarch        string
+	tests         bool
+	platformList  string
+	platforms     []platform
+	roles         []*parser.Role
+	compose       bool
+	commons       []*parser.CommonInterface
+	signatures    string
 	interestTypes map[string]struct{}
+	typeNames     map[string]string
 )
 
+// defaultOutputFile is the output file of each package when several packages are generated.
+const defaultOutputFile = "interfaces_gen.go"
+
 func init() {
-	flag.StringVar(&inputFile, "i", "", "Input file or directory. By default, the program reads from stdin.")
-	flag.StringVar(&outputFile, "o", "", "Output file. By default, the program writes content to stdout.")
-	flag.StringVar(&types, "t", "", "Specify the types. Multiple types are separated by comma(,). Extract all types if not specified.")
-	flag.StringVar(&pkgName, "p", "", "Package name.")
-	flag.BoolVar(&private, "private", false, "Include private methods.")
+	flag.Var(&inputs, "i", "Input file or directory, dir/... includes the subdirectories. May be repeated. By default, the program reads from stdin.")
+	flag.StringVar(&importPkg, "pkg", "", "Import path of the input package, e.g. net/http, instead of -i. Its source is found in the main modules, GOROOT, the vendor directory of the main modules or the module cache at the version of go.mod or go.sum, without downloading it. Requires -p.")
+	flag.BoolVar(&inPlace, "w", false, "Write the output into the package directories, to the file of -o, "+defaultOutputFile+" by default.")
+	flag.BoolVar(&check, "check", false, "Check that the output files are up to date instead of writing them. The differences are printed and the program exits with 1.")
+	flag.StringVar(&outputRoot, "out-root", "", "Write the output of each package under this directory, mirroring the package directories.")
+	generationFlags(flag.CommandLine)
+	buildFlags(flag.CommandLine)
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage of %s:\n", os.Args[0])
+		flag.PrintDefaults()
+		fmt.Fprintf(flag.CommandLine.Output(), "\n%s generate [flags] [packages] generates the interfaces of the annotated types, see %s generate -h.\n", os.Args[0], os.Args[0])
+	}
+}
+
+// generationFlags defines the flags of the generated code, they are also the flags of the directives.
+func generationFlags(fs *flag.FlagSet) {
+	fs.StringVar(&outputFile, "o", "", "Output file. By default, the program writes content to stdout. With several packages, the file name in each package directory, "+defaultOutputFile+" by default.")
+	fs.StringVar(&types, "t", "", "Specify the types. Multiple types are separated by comma(,). Extract all types if not specified. Type=Name names the interface of Type.")
+	fs.StringVar(&nameTemplate, "name", parser.DefaultNameTemplate, "Template of the interface names, with .Type, .Receiver (pointer or value), .Suffix (Value for the value interface of a type with both) and .Package.")
+	fs.StringVar(&pkgName, "p", "", "Package name of the output. In another package than the one of the types, the identifiers of the types' package are qualified and the package is imported.")
+	fs.BoolVar(&private, "private", false, "Include private methods.")
+	fs.BoolVar(&promoted, "promoted", false, "Include methods promoted from embedded fields. Embedded types of other packages require the ast backend.")
+	fs.StringVar(&receivers, "receivers", "split", "How methods are split by receiver: split (I{Type} has the pointer receiver methods, I{Type}Value the value receiver ones), pointer-set (I{Type} has the method set of *Type, I{Type}Value the one of Type) or value-only (I{Type} has the method set of Type).")
+	fs.BoolVar(&assertions, "assert", false, "Emit compile-time assertions that the types implement their interfaces.")
+	fs.BoolVar(&mock, "mock", false, "Also generate a mock implementation of every interface.")
+	fs.StringVar(&mockFile, "mock-o", "", "Output file of the mocks. By default, the mocks are written along with the interfaces.")
+	fs.StringVar(&mockPkgName, "mock-p", "", "Package name of the mocks, requires -mock-o. By default, the package of the interfaces.")
+	roles = nil
+	fs.Func("role", "Role=regexp moves the methods whose name matches regexp to the interface {Type}{Role}, e.g. Reader=^(Get|List). May be repeated, a method goes to the first matching role.", func(s string) error {
+		r, err := parser.ParseRole(s)
+		if err != nil {
+			return err
+		}
+		roles = append(roles, r)
+		return nil
+	})
+	fs.StringVar(&signatures, "signatures", "source", "How the signatures are written: source (as in the sources), canonical (gofmt spacing on one line, consecutive parameters of the same type grouped) or unnamed (canonical without the parameter names).")
+	commons = nil
+	fs.Func("common", "Name=TypeA,TypeB generates the interface Name with the methods the types share, instead of the interfaces of the types. May be repeated.", func(s string) error {
+		c, err := parser.ParseCommon(s)
+		if err != nil {
+			return err
+		}
+		commons = append(commons, c)
+		return nil
+	})
+	fs.BoolVar(&compose, "compose", false, "Make the interface of a type embed the interfaces of its roles and groups.")
+}
+
+// buildFlags defines the flags of the extraction.
+func buildFlags(fs *flag.FlagSet) {
+	fs.StringVar(&backend, "backend", "antlr", "Extraction backend, antlr or ast. The ast backend uses go/packages and go/types.")
+	fs.StringVar(&buildTags, "tags", "", "Build tags, separated by comma(,). Files excluded by build constraints are skipped.")
+	fs.StringVar(&goos, "goos", "", "GOOS of the build constraints and file name suffixes. By default, the GOOS of the go command.")
+	fs.StringVar(&goarch, "goarch", "", "GOARCH of the build constraints and file name suffixes. By default, the GOARCH of the go command.")
+	fs.StringVar(&platformList, "platforms", "", "GOOS/GOARCH pairs separated by comma(,), e.g. linux/amd64,windows/amd64. The interfaces only have the methods of every platform, the others are emitted as comments.")
+	fs.BoolVar(&tests, "tests", false, "Include the _test.go files of the package.")
+	fs.BoolVar(&skipErrors, "skip-errors", false, "Skip files that cannot be parsed and generate interfaces for the rest.")
+	fs.IntVar(&jobs, "j", runtime.GOMAXPROCS(0), "Number of files the antlr backend parses in parallel. By default, the number of CPUs.")
+	fs.BoolVar(&skipBodies, "skip-bodies", false, "Don't parse the function bodies with the antlr backend, which is faster. The syntax errors inside the bodies are not reported.")
+}
+
+// fatal reports err on stderr and exits.
+func fatal(err error) {
+	fmt.Fprintf(os.Stderr, "gointerface: %v\n", err)
+	os.Exit(1)
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "generate" {
+		generateDirectives(os.Args[2:])
+	} else {
+		flag.Parse()
+		checkBuildFlags()
+		gen, err := newGenerator()
+		if err != nil {
+			fatal(err)
+		}
+		generateAll(gen)
+	}
+	if drifted {
+		os.Exit(1)
+	}
+}
 
-	flag.Parse()
+// checkBuildFlags validates the flags of the extraction.
+func checkBuildFlags() {
+	if platformList != "" {
+		var err error
+		if platforms, err = parsePlatforms(platformList); err != nil {
+			fatal(err)
+		}
+	}
+	if backend != "antlr" && backend != "ast" {
+		fatal(fmt.Errorf("unknown backend %q, expected ast or antlr", backend))
+	}
+	if jobs < 1 {
+		fatal(fmt.Errorf("-j must be at least 1"))
+	}
+}
 
+// newGenerator returns the generator configured by the generation flags.
+func newGenerator() (*parser.InterfaceGenerator, error) {
+	interestTypes, typeNames = nil, nil
 	if types != "" {
 		tmpTypes := strings.Split(types, ",")
 		interestTypes = make(map[string]struct{})
+		typeNames = make(map[string]string)
 		for _, t := range tmpTypes {
+			// Type=Name
+			if i := strings.IndexByte(t, '='); i >= 0 {
+				typeNames[t[:i]] = t[i+1:]
+				t = t[:i]
+			}
 			interestTypes[t] = struct{}{}
 		}
 	}
 
-	var fileInfoList []*parser.SourceFileInfo
+	receiverMode, err := parser.ParseReceiverMode(receivers)
+	if err != nil {
+		return nil, err
+	}
+	signatureStyle, err := parser.ParseSignatureStyle(signatures)
+	if err != nil {
+		return nil, err
+	}
+	if mockPkgName != "" && mockFile == "" {
+		return nil, fmt.Errorf("-mock-p requires -mock-o")
+	}
+	gen := &parser.InterfaceGenerator{Types: interestTypes, PkgName: pkgName, Promoted: promoted, Receivers: receiverMode, Assertions: assertions,
+		NameTemplate: nameTemplate, Names: typeNames, Roles: roles, Compose: compose,
+		Common: commons, Signatures: signatureStyle, Report: report}
+	gen.Mock = mock && mockFile == ""
+	return gen, nil
+}
 
-	if inputFile == "" || inputFile == "-" { // read from stdin
-		raw, err := ioutil.ReadAll(os.Stdin)
-		if err != nil {
-			panic(err)
+// generateAll generates the interfaces of the inputs.
+func generateAll(gen *parser.InterfaceGenerator) {
+	if importPkg != "" {
+		generateImported(gen)
+		return
+	}
+	if len(inputs) == 0 || (len(inputs) == 1 && inputs[0] == "-") { // read from stdin
+		if inPlace {
+			fatal(fmt.Errorf("-w requires a package, not stdin"))
 		}
-		input := antlr.NewInputStream(string(raw))
-		fileInfo, err := analyze(input)
-		if err != nil {
-			panic(err)
+		gen.Files = load(nil)
+		generate(gen, outputFile, mockFile)
+		return
+	}
+
+	pkgs, err := expandInputs(inputs)
+	if err != nil {
+		fatal(err)
+	}
+	perPackage := len(pkgs) > 1 || outputRoot != "" || inPlace
+	for _, input := range inputs {
+		if _, ok := recursivePattern(input); ok {
+			perPackage = true
 		}
-		fileInfoList = []*parser.SourceFileInfo{fileInfo}
+	}
+	if !perPackage {
+		gen.Files = load(pkgs[0])
+		generate(gen, outputFile, mockFile)
+		return
+	}
 
-	} else {
-		info, err := os.Stat(inputFile)
+	// one output file per package
+	outputName := outputFile
+	if outputName == "" {
+		outputName = defaultOutputFile
+	}
+	for _, pkg := range pkgs {
+		output, err := outputPath(outputName, pkg)
 		if err != nil {
-			panic(err)
+			fatal(err)
 		}
-		if info.IsDir() { // is package
-			files, err := ioutil.ReadDir(inputFile)
-			if err != nil {
-				panic(err)
-			}
-			// sort files by name
-			sort.Slice(files, func(i, j int) bool { return files[i].Name() < files[j].Name() })
-
-			for _, f := range files {
-				if f.IsDir() {
-					continue
-				}
-				if filepath.Ext(f.Name()) != ".go" {
-					continue
-				}
-				filename := filepath.Join(inputFile, f.Name())
-				input, err := antlr.NewFileStream(filename)
-				if err != nil {
-					panic(err)
-				}
-				fileInfo, err := analyze(input)
-				if err != nil {
-					panic(err)
-				}
-				fileInfoList = append(fileInfoList, fileInfo)
+		mockOutput := ""
+		if mockFile != "" {
+			if mockOutput, err = outputPath(mockFile, pkg); err != nil {
+				fatal(err)
 			}
-			if len(fileInfoList) == 0 {
-				panic("Go file not found")
+		}
+		gen.Files = load(pkg)
+		setOutputs(gen, output, mockOutput)
+		names, err := gen.InterfaceNames()
+		if err != nil {
+			fatal(fmt.Errorf("%s: %w", pkg.Dir, err))
+		}
+		if len(names) == 0 {
+			// the files of a previous run are stale
+			removeOutput(output)
+			if mock && mockOutput != "" {
+				removeOutput(mockOutput)
 			}
-		} else { // is go file
-			input, err := antlr.NewFileStream(inputFile)
-			if err != nil {
-				panic(err)
+			continue
+		}
+		generate(gen, output, mockOutput)
+	}
+}
+
+// generateImported generates the interfaces of the package of -pkg into the package of -p.
+func generateImported(gen *parser.InterfaceGenerator) {
+	switch {
+	case len(inputs) != 0:
+		fatal(fmt.Errorf("-pkg and -i cannot be used together"))
+	case inPlace || outputRoot != "":
+		fatal(fmt.Errorf("-pkg cannot write into the package, use -o"))
+	case gen.PkgName == "":
+		fatal(fmt.Errorf("-pkg requires -p, the package of the output"))
+	}
+	pkg, err := importedPackage(importPkg)
+	if err != nil {
+		fatal(err)
+	}
+	gen.Files = load(pkg)
+	generate(gen, outputFile, mockFile)
+}
+
+// load extracts the methods of pkg, or of stdin if pkg is nil, with the selected backend.
+// With several platforms, the results of the platforms are merged.
+func load(pkg *packageInput) []*parser.SourceFileInfo {
+	if pkg == nil || len(platforms) == 0 {
+		return loadPlatform(pkg, targetPlatforms()[0])
+	}
+	names := make([]string, 0, len(platforms))
+	variants := make([][]*parser.SourceFileInfo, 0, len(platforms))
+	for _, p := range platforms {
+		names = append(names, p.String())
+		variants = append(variants, loadPlatform(pkg, p))
+	}
+	merged, diagnostics := parser.MergeVariants(names, variants)
+	for _, d := range diagnostics {
+		fmt.Fprintln(os.Stderr, d)
+	}
+	return merged
+}
+
+// reported holds the diagnostics already reported, the files shared by the platforms are
+// loaded several times.
+var reported = map[string]struct{}{}
+
+// report prints d on stderr unless it is already reported.
+func report(d *parser.Diagnostic) {
+	if _, ok := reported[d.String()]; !ok {
+		reported[d.String()] = struct{}{}
+		fmt.Fprintln(os.Stderr, d)
+	}
+}
+
+// loadPlatform extracts the methods of pkg on p. The diagnostics are reported, files with
+// errors abort the generation unless they are skipped.
+func loadPlatform(pkg *packageInput, p platform) []*parser.SourceFileInfo {
+	var fileInfoList []*parser.SourceFileInfo
+	switch backend {
+	case "antlr":
+		fileInfoList = loadANTLR(pkg, p)
+	case "ast":
+		fileInfoList = loadAST(pkg, p)
+	}
+
+	failed := false
+	validFiles := make([]*parser.SourceFileInfo, 0, len(fileInfoList))
+	for _, f := range fileInfoList {
+		for _, d := range f.Diagnostics {
+			report(d)
+		}
+		if f.HasErrors() {
+			failed = true
+			continue
+		}
+		validFiles = append(validFiles, f)
+	}
+	if failed && !skipErrors {
+		os.Exit(1)
+	}
+	if pkg != nil {
+		setImportPath(validFiles, pkg)
+	}
+	setImportNames(validFiles)
+	return withoutExternalTests(validFiles)
+}
+
+// modules resolves the import paths of the packages from their go.mod, relative to the
+// main modules of the working directory.
+var modules = parser.NewModuleResolver(".")
+
+// setImportNames sets the package names of the unaliased imports of files whose sources
+// are found, the names guessed from the import paths may be wrong.
+func setImportNames(files []*parser.SourceFileInfo) {
+	for _, f := range files {
+		for _, imp := range f.Imports {
+			if imp.Alias != "" || imp.Name != "" {
+				continue
 			}
-			fileInfo, err := analyze(input)
-			if err != nil {
-				panic(err)
+			if path, err := strconv.Unquote(imp.Path); err == nil {
+				imp.Name, _ = modules.PackageName(path)
 			}
-			fileInfoList = []*parser.SourceFileInfo{fileInfo}
 		}
 	}
+}
+
+// setImportPath sets the import path of pkg on its files. The path of the ast backend is
+// kept if the module of the directory cannot be resolved, e.g. outside of a module.
+func setImportPath(files []*parser.SourceFileInfo, pkg *packageInput) {
+	pkgPath := pkg.ImportPath
+	if pkgPath == "" {
+		var err error
+		if pkgPath, err = modules.ImportPath(pkg.Dir); err != nil {
+			return
+		}
+	}
+	for _, f := range files {
+		if strings.HasSuffix(f.PkgName, "_test") {
+			f.PkgPath = pkgPath + "_test"
+		} else {
+			f.PkgPath = pkgPath
+		}
+	}
+}
+
+// withoutExternalTests leaves out the files of the external test package, e.g. package
+// foo_test, they are a package of their own.
+func withoutExternalTests(files []*parser.SourceFileInfo) []*parser.SourceFileInfo {
+	result := make([]*parser.SourceFileInfo, 0, len(files))
+	for _, f := range files {
+		if !strings.HasSuffix(f.PkgName, "_test") {
+			result = append(result, f)
+		}
+	}
+	if len(result) == 0 {
+		// only the test package is given
+		return files
+	}
+	return result
+}
+
+// buildConfig returns the build configuration of the ast backend on p.
+func buildConfig(p platform) parser.BuildConfig {
+	config := parser.BuildConfig{GOOS: p.GOOS, GOARCH: p.GOARCH, Tests: tests}
+	if buildTags != "" {
+		config.Tags = strings.Split(buildTags, ",")
+	}
+	return config
+}
 
-	gen := parser.InterfaceGenerator{Files: fileInfoList, Types: interestTypes, PkgName: pkgName}
+// generate writes the interfaces to output and the mocks to mockOutput if it is set.
+func generate(gen *parser.InterfaceGenerator, output, mockOutput string) {
+	setOutputs(gen, output, mockOutput)
 	code, err := gen.GenerateCode()
 	if err != nil {
-		panic(err)
+		fatal(err)
+	}
+	writeOutput(output, code)
+
+	if mock && mockOutput != "" {
+		mockCode, err := gen.GenerateMock(mockPkgName)
+		if err != nil {
+			fatal(err)
+		}
+		writeOutput(mockOutput, mockCode)
+	}
+}
+
+// setOutputs sets the files gen writes, their declarations are replaced.
+func setOutputs(gen *parser.InterfaceGenerator, output, mockOutput string) {
+	gen.Outputs = nil
+	for _, file := range []string{output, mockOutput} {
+		if file != "" {
+			gen.Outputs = append(gen.Outputs, file)
+		}
 	}
-	if outputFile == "" { // write to stdout
+}
+
+// checkOutput prints the diff between file and code, if any, and sets drifted.
+func checkOutput(file string, code string) {
+	if file == "" {
+		fatal(fmt.Errorf("-check requires an output file"))
+	}
+	old, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		fatal(err)
+	}
+	if string(old) == code {
+		return
+	}
+	drifted = true
+	fmt.Print(unifiedDiff(file, file+" (generated)", string(old), code))
+}
+
+// drifted is set if -check finds an output that is not up to date.
+var drifted bool
+
+// writeOutput writes code to file, or to stdout if file is empty. An existing file is only
+// replaced if gointerface generated it, and only if the content changes.
+// With -check, the file is compared with code instead.
+func writeOutput(file string, code string) {
+	if check {
+		checkOutput(file, code)
+		return
+	}
+	if file == "" { // write to stdout
 		fmt.Println(code)
-	} else {
-		f, err := os.Create(outputFile)
+		return
+	}
+	old, err := ioutil.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		fatal(err)
+	}
+	if err == nil {
+		if string(old) == code {
+			return
+		}
+		if len(bytes.TrimSpace(old)) != 0 && !parser.IsGenerated(old) {
+			fatal(fmt.Errorf("%s was not generated by gointerface, refusing to overwrite it", file))
+		}
+	}
+	if outputRoot != "" {
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			fatal(err)
+		}
+	}
+	// the mode of an existing file is kept
+	if err := ioutil.WriteFile(file, []byte(code), 0o644); err != nil {
+		fatal(err)
+	}
+}
+
+// removeOutput removes file if gointerface generated it, for a package that has no
+// interfaces anymore. With -check, the removal is printed as a diff and sets drifted.
+func removeOutput(file string) {
+	old, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return
+	}
+	if err != nil {
+		fatal(err)
+	}
+	if !parser.IsGenerated(old) {
+		return
+	}
+	if check {
+		drifted = true
+		fmt.Print(unifiedDiff(file, file+" (generated)", string(old), ""))
+		return
+	}
+	if err := os.Remove(file); err != nil {
+		fatal(err)
+	}
+}
+
+// loadANTLR parses the input with the ANTLR Go grammar.
+func loadANTLR(pkg *packageInput, p platform) []*parser.SourceFileInfo {
+	if pkg == nil { // read from stdin
+		raw, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fatal(err)
+		}
+		input := antlr.NewInputStream(string(raw))
+		return []*parser.SourceFileInfo{analyze("<stdin>", input)}
+	}
+
+	files := pkg.Files
+	if !pkg.Single {
+		var err error
+		if files, err = goFiles(pkg.Dir, p); err != nil {
+			fatal(err)
+		}
+	}
+	return analyzeFiles(files)
+}
+
+// analyzeFiles parses files with -j workers, each with a parser of its own. The results
+// are in the order of files, and the files that cannot be read have an error diagnostic.
+func analyzeFiles(files []string) []*parser.SourceFileInfo {
+	fileInfoList := make([]*parser.SourceFileInfo, len(files))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < jobs && w < len(files); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				fileInfoList[i] = analyzeFile(files[i])
+			}
+		}()
+	}
+	for i := range files {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return fileInfoList
+}
+
+// analyzeFile parses the Go file filename.
+func analyzeFile(filename string) *parser.SourceFileInfo {
+	input, err := antlr.NewFileStream(filename)
+	if err != nil {
+		return &parser.SourceFileInfo{FileName: filename, Diagnostics: []*parser.Diagnostic{
+			{File: filename, Line: 1, Column: 1, Message: err.Error(), Severity: parser.SeverityError},
+		}}
+	}
+	return analyze(filename, input)
+}
+
+// loadAST loads the input with go/packages and go/types.
+func loadAST(pkg *packageInput, p platform) []*parser.SourceFileInfo {
+	if pkg == nil { // read from stdin
+		raw, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			fatal(err)
+		}
+		return []*parser.SourceFileInfo{parser.ParseSource("<stdin>", raw, private)}
+	}
+
+	if !pkg.Single { // is package
+		fileInfoList, err := parser.LoadPackage(pkg.Dir, nil, buildConfig(p), private)
 		if err != nil {
-			panic(err)
+			fatal(err)
+		}
+		if len(fileInfoList) == 0 {
+			fatal(fmt.Errorf("no Go files in %s", pkg.Dir))
 		}
-		// remember to close the file
-		defer f.Close()
-		_, err = f.WriteString(code)
+		return fileInfoList
+	}
+
+	// is go file
+	inputFile := pkg.Files[0]
+	fileInfoList, err := parser.LoadPackage(pkg.Dir, []string{inputFile}, buildConfig(p), private)
+	if err != nil {
+		fatal(err)
+	}
+	if len(fileInfoList) == 0 {
+		// not part of the package, e.g. excluded by build constraints
+		raw, err := ioutil.ReadFile(inputFile)
 		if err != nil {
-			panic(err)
+			fatal(err)
 		}
+		fileInfoList = []*parser.SourceFileInfo{parser.ParseSource(inputFile, raw, private)}
 	}
+	return fileInfoList
 }
 
-func analyze(input antlr.CharStream) (*parser.SourceFileInfo, error) {
+// analyze parses a Go file, syntax errors are returned as diagnostics of the file.
+func analyze(filename string, input antlr.CharStream) *parser.SourceFileInfo {
+	errListener := parser.NewErrorListener(filename)
 	lexer := parser.NewGoLexer(input)
-	stream := antlr.NewCommonTokenStream(lexer, antlr.LexerDefaultTokenChannel)
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	var source antlr.Lexer = lexer
+	if skipBodies {
+		source = parser.SkipBodies(lexer)
+	}
+	stream := antlr.NewCommonTokenStream(source, antlr.LexerDefaultTokenChannel)
 	p := parser.NewGoParser(stream)
 	p.BuildParseTrees = true
-	p.AddErrorListener(parser.NewErrorListener())
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errListener)
 	tree := p.SourceFile()
+	if len(errListener.Diagnostics) != 0 {
+		// the tree is incomplete, don't walk it
+		return &parser.SourceFileInfo{FileName: filename, Diagnostics: errListener.Diagnostics}
+	}
 	listener := parser.NewMethodListener(private)
 	walker := antlr.ParseTreeWalkerDefault
 	walker.Walk(listener, tree)
-	return listener.GetResult(), nil
+	fileInfo := listener.GetResult()
+	fileInfo.FileName = filename
+	for _, d := range fileInfo.Diagnostics {
+		d.File = filename
+	}
+	return fileInfo
 }
@@ -122,6 +122,7 @@ CARET                  : '^';
 STAR                   : '*';
 AMPERSAND              : '&';
 RECEIVE                : '<-';
+UNDERLYING             : '~';
 
 // Number literals
 
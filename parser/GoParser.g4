@@ -59,11 +59,20 @@ expressionList: expression (COMMA expression)*;
 
 typeDecl: TYPE (typeSpec | L_PAREN (typeSpec eos)* R_PAREN);
 
-typeSpec: IDENTIFIER ASSIGN? type_;
+typeSpec: IDENTIFIER typeParameters? ASSIGN? type_;
+
+typeParameters:
+	L_BRACKET typeParameterDecl (COMMA typeParameterDecl)* COMMA? R_BRACKET;
+
+typeParameterDecl: identifierList typeElement;
+
+typeElement: typeTerm (OR typeTerm)*;
+
+typeTerm: UNDERLYING? type_;
 
 // Function declarations
 
-functionDecl: FUNC IDENTIFIER (signature block?);
+functionDecl: FUNC IDENTIFIER typeParameters? (signature block?);
 
 methodDecl: FUNC receiver IDENTIFIER ( signature block?);
 
@@ -198,7 +207,9 @@ rangeClause: (
 
 goStmt: GO expression;
 
-type_: typeName | typeLit | L_PAREN type_ R_PAREN;
+type_: typeName typeArgs? | typeLit | L_PAREN type_ R_PAREN;
+
+typeArgs: L_BRACKET typeList COMMA? R_BRACKET;
 
 typeName: qualifiedIdent | IDENTIFIER;
 
@@ -222,7 +233,7 @@ elementType: type_;
 pointerType: STAR type_;
 
 interfaceType:
-	INTERFACE L_CURLY ((methodSpec | typeName) eos)* R_CURLY;
+	INTERFACE L_CURLY ((methodSpec | typeElement) eos)* R_CURLY;
 
 sliceType: L_BRACKET R_BRACKET elementType;
 
@@ -298,7 +309,7 @@ conversion: nonNamedType L_PAREN expression COMMA? R_PAREN;
 
 nonNamedType: typeLit | L_PAREN nonNamedType R_PAREN;
 
-operand: literal | operandName | L_PAREN expression R_PAREN;
+operand: literal | operandName typeArgs? | L_PAREN expression R_PAREN;
 
 literal: basicLit | compositeLit | functionLit;
 
@@ -328,7 +339,7 @@ literalType:
 	| L_BRACKET ELLIPSIS R_BRACKET elementType
 	| sliceType
 	| mapType
-	| typeName;
+	| typeName typeArgs?;
 
 literalValue: L_CURLY (elementList COMMA?)? R_CURLY;
 
@@ -349,7 +360,7 @@ fieldDecl: (
 
 string_: RAW_STRING_LIT | INTERPRETED_STRING_LIT;
 
-embeddedField: STAR? typeName;
+embeddedField: STAR? typeName typeArgs?;
 
 functionLit: FUNC signature block; // function
 
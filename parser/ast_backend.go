@@ -0,0 +1,458 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	goparser "go/parser"
+	"go/printer"
+	"go/scanner"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// BuildConfig selects the files of a package like the flags of the go command.
+type BuildConfig struct {
+	Tags   []string
+	GOOS   string // empty for the default of the go command
+	GOARCH string
+	Tests  bool // include the _test.go files
+}
+
+// LoadPackage extracts the methods of the package in dir with go/packages.
+// If files is not empty, only the results of these files are returned.
+func LoadPackage(dir string, files []string, build BuildConfig, includePrivate bool) ([]*SourceFileInfo, error) {
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedFiles | packages.NeedSyntax | packages.NeedImports | packages.NeedDeps |
+			packages.NeedTypes | packages.NeedTypesInfo,
+		Dir:   dir,
+		Tests: build.Tests,
+	}
+	if len(build.Tags) != 0 {
+		cfg.BuildFlags = []string{"-tags=" + strings.Join(build.Tags, ",")}
+	}
+	if build.GOOS != "" || build.GOARCH != "" {
+		cfg.Env = os.Environ()
+		if build.GOOS != "" {
+			cfg.Env = append(cfg.Env, "GOOS="+build.GOOS)
+		}
+		if build.GOARCH != "" {
+			cfg.Env = append(cfg.Env, "GOARCH="+build.GOARCH)
+		}
+	}
+	pkgs, err := packages.Load(cfg, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		abs, err := filepath.Abs(f)
+		if err != nil {
+			return nil, err
+		}
+		wanted[abs] = struct{}{}
+	}
+
+	var result []*SourceFileInfo
+	seen := map[string]struct{}{}
+	for _, pkg := range pkgs {
+		if strings.HasSuffix(pkg.ID, ".test") {
+			continue // the generated test main
+		}
+		diagnostics := map[string][]*Diagnostic{}
+		for _, e := range pkg.Errors {
+			d := packageDiagnostic(e)
+			diagnostics[d.File] = append(diagnostics[d.File], d)
+		}
+		if len(pkg.Syntax) == 0 && len(pkg.Errors) != 0 {
+			return nil, fmt.Errorf("%s", pkg.Errors[0].Msg)
+		}
+		for _, file := range pkg.Syntax {
+			// file.Pos() is not valid without a package clause
+			filename := pkg.Fset.File(file.FileStart).Name()
+			if len(wanted) != 0 {
+				if _, ok := wanted[filename]; !ok {
+					continue
+				}
+			}
+			// with tests, the files of the package are also in its test variant
+			if _, ok := seen[filename]; ok {
+				continue
+			}
+			seen[filename] = struct{}{}
+			if file.Name == nil || !file.Package.IsValid() {
+				result = append(result, invalidFile(filename, diagnostics[filename]))
+				continue
+			}
+			src, err := os.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			fileInfo := extractFile(pkg.Fset, file, src, pkg.Types, pkg.TypesInfo, includePrivate)
+			fileInfo.FileName = filename
+			fileInfo.PkgPath = pkg.PkgPath
+			for _, imp := range fileInfo.Imports {
+				if path, err := strconv.Unquote(imp.Path); err == nil && pkg.Imports[path] != nil {
+					imp.Name = pkg.Imports[path].Name
+				}
+			}
+			fileInfo.Diagnostics = append(diagnostics[filename], fileInfo.Diagnostics...)
+			result = append(result, fileInfo)
+		}
+	}
+	return result, nil
+}
+
+// ParseSource extracts the methods of a single file, outside of any package.
+func ParseSource(filename string, src []byte, includePrivate bool) *SourceFileInfo {
+	fset := token.NewFileSet()
+	file, err := goparser.ParseFile(fset, filename, src, goparser.ParseComments)
+	if err != nil {
+		fileInfo := &SourceFileInfo{FileName: filename}
+		if list, ok := err.(scanner.ErrorList); ok {
+			for _, e := range list {
+				fileInfo.Diagnostics = append(fileInfo.Diagnostics, &Diagnostic{
+					File: filename, Line: e.Pos.Line, Column: e.Pos.Column, Message: e.Msg, Severity: SeverityError})
+			}
+		} else {
+			fileInfo.Diagnostics = append(fileInfo.Diagnostics, &Diagnostic{
+				File: filename, Line: 1, Column: 1, Message: err.Error(), Severity: SeverityError})
+		}
+		return fileInfo
+	}
+
+	// the imports may not be resolvable, type errors are ignored
+	info := &types.Info{Defs: map[*ast.Ident]types.Object{}}
+	conf := types.Config{Importer: importer.Default(), Error: func(error) {}}
+	pkg, _ := conf.Check(file.Name.Name, fset, []*ast.File{file}, info)
+
+	fileInfo := extractFile(fset, file, src, pkg, info, includePrivate)
+	fileInfo.FileName = filename
+	return fileInfo
+}
+
+// extractFile builds the SourceFileInfo of a type checked file. The text of the
+// signatures and comments is taken from src, the same way the ANTLR listener does.
+func extractFile(fset *token.FileSet, file *ast.File, src []byte, pkg *types.Package, info *types.Info, includePrivate bool) *SourceFileInfo {
+	tf := fset.File(file.FileStart)
+	text := func(from, to token.Pos) string {
+		return string(src[tf.Offset(from):tf.Offset(to)])
+	}
+
+	fileInfo := &SourceFileInfo{FileName: tf.Name(), PkgName: file.Name.Name}
+	for _, group := range file.Comments {
+		for _, c := range group.List {
+			if c.Pos() < file.Package && isGeneratedComment(c.Text) {
+				fileInfo.Generated = true
+			}
+		}
+	}
+	for _, imp := range file.Imports {
+		var alias string
+		if imp.Name != nil {
+			alias = imp.Name.Name
+		}
+		if alias == "_" {
+			continue
+		}
+		fileInfo.Imports = append(fileInfo.Imports, &ImportStmt{Alias: alias, Path: imp.Path.Value})
+	}
+
+	prevEnd := file.Name.End()
+	for _, decl := range file.Decls {
+		start := prevEnd
+		prevEnd = decl.End()
+
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok == token.CONST || decl.Tok == token.VAR {
+				for _, spec := range decl.Specs {
+					for _, name := range spec.(*ast.ValueSpec).Names {
+						fileInfo.Decls = append(fileInfo.Decls, name.Name)
+					}
+				}
+				continue
+			}
+			if decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				ts := spec.(*ast.TypeSpec)
+				typeDecl := &TypeDecl{Identifier: ts.Name.Name}
+				if ts.TypeParams != nil {
+					for _, field := range ts.TypeParams.List {
+						constraint := text(field.Type.Pos(), field.Type.End())
+						for _, name := range field.Names {
+							typeDecl.TypeParams = append(typeDecl.TypeParams, &TypeParam{Name: name.Name, Constraint: constraint})
+						}
+					}
+				}
+				switch t := ts.Type.(type) {
+				case *ast.StructType:
+					collectStructFields(typeDecl, t, fileInfo, pkg, info)
+				case *ast.InterfaceType:
+					collectInterfaceMethods(typeDecl, ts, fileInfo, pkg, info, includePrivate)
+				}
+				doc := ts.Doc
+				if !decl.Lparen.IsValid() {
+					doc = decl.Doc
+				}
+				if doc != nil {
+					for _, c := range doc.List {
+						pos := fset.Position(c.Slash)
+						annotateType(fileInfo, typeDecl, c.Text, pos.Line, pos.Column)
+					}
+				}
+				fileInfo.Types = append(fileInfo.Types, typeDecl)
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || len(decl.Recv.List) == 0 {
+				fileInfo.Decls = append(fileInfo.Decls, decl.Name.Name)
+				continue
+			}
+			ident := decl.Name.Name
+			if !includePrivate && unicode.IsLower(rune(ident[0])) {
+				continue
+			}
+			recv := receiverDecl(decl.Recv.List[0].Type)
+			if obj, ok := info.Defs[decl.Name].(*types.Func); ok {
+				// resolves receivers declared through type aliases
+				if named := receiverNamed(obj); named != nil {
+					recv.StructType = named.Obj().Name()
+				}
+			}
+
+			sig := decl.Type
+			end := sig.Params.End()
+			if sig.Results != nil {
+				end = sig.Results.End()
+			}
+			m := &MethodDecl{
+				Recv:       recv,
+				Identifier: ident,
+				Signature:  text(sig.Params.Pos(), end),
+				Sig:        signatureFromAST(sig),
+				Comment:    leadingComment(text(start, decl.Pos())),
+				Line:       fset.Position(decl.Pos()).Line,
+			}
+			if annotateMethod(fileInfo, m) {
+				fileInfo.Methods = append(fileInfo.Methods, m)
+			}
+		}
+	}
+	return fileInfo
+}
+
+// collectStructFields records the field names and the embedded fields of a struct type.
+// The method sets of embedded types of other packages are resolved with go/types.
+func collectStructFields(decl *TypeDecl, st *ast.StructType, fileInfo *SourceFileInfo, pkg *types.Package, info *types.Info) {
+	for _, field := range st.Fields.List {
+		if len(field.Names) != 0 {
+			for _, name := range field.Names {
+				decl.Fields = append(decl.Fields, name.Name)
+			}
+			continue
+		}
+		embedded := &EmbeddedField{}
+		expr := field.Type
+		if star, ok := expr.(*ast.StarExpr); ok {
+			embedded.IsPointer = true
+			expr = star.X
+		}
+		switch x := expr.(type) {
+		case *ast.IndexExpr:
+			embedded.TypeArgs = []string{exprString(x.Index)}
+			expr = x.X
+		case *ast.IndexListExpr:
+			for _, index := range x.Indices {
+				embedded.TypeArgs = append(embedded.TypeArgs, exprString(index))
+			}
+			expr = x.X
+		}
+		switch x := expr.(type) {
+		case *ast.Ident:
+			embedded.TypeName = x.Name
+		case *ast.SelectorExpr:
+			embedded.Pkg = exprString(x.X)
+			embedded.TypeName = x.Sel.Name
+		}
+		// also covers the types of dot imports
+		if named, ok := info.TypeOf(expr).(*types.Named); ok && named.Obj().Pkg() != nil && named.Obj().Pkg() != pkg {
+			embedded.Methods = externalMethods(named, fileInfo, pkg)
+		}
+		decl.Fields = append(decl.Fields, embedded.TypeName)
+		decl.Embedded = append(decl.Embedded, embedded)
+	}
+}
+
+// collectInterfaceMethods records the method set of an interface type, the methods of
+// its embedded interfaces included.
+func collectInterfaceMethods(decl *TypeDecl, ts *ast.TypeSpec, fileInfo *SourceFileInfo, pkg *types.Package, info *types.Info, includePrivate bool) {
+	decl.IsInterface = true
+	obj := info.Defs[ts.Name]
+	if obj == nil {
+		return
+	}
+	iface, ok := obj.Type().Underlying().(*types.Interface)
+	if !ok {
+		return
+	}
+	for i := 0; i < iface.NumMethods(); i++ {
+		fn := iface.Method(i)
+		if !fn.Exported() && (!includePrivate || fn.Pkg() != pkg) {
+			continue
+		}
+		var buf bytes.Buffer
+		types.WriteSignature(&buf, fn.Type().(*types.Signature), fileQualifier(fileInfo, pkg))
+		decl.Methods = append(decl.Methods, &MethodDecl{
+			Recv:       &ReceiverDecl{StructType: decl.Identifier},
+			Identifier: fn.Name(),
+			Signature:  buf.String(),
+		})
+	}
+}
+
+// externalMethods returns the method set of *t, t being a type of another package.
+// Packages the signatures refer to are added to the imports of the file.
+func externalMethods(t *types.Named, fileInfo *SourceFileInfo, pkg *types.Package) []*MethodDecl {
+	valueSet := types.NewMethodSet(t)
+	ptrSet := types.NewMethodSet(types.NewPointer(t))
+	if types.IsInterface(t) {
+		ptrSet = valueSet // a pointer to an interface has no methods
+	}
+
+	var methods []*MethodDecl
+	for i := 0; i < ptrSet.Len(); i++ {
+		fn := ptrSet.At(i).Obj().(*types.Func)
+		if !fn.Exported() {
+			continue // unexported methods of other packages cannot be called
+		}
+		var buf bytes.Buffer
+		types.WriteSignature(&buf, fn.Type().(*types.Signature), fileQualifier(fileInfo, pkg))
+		methods = append(methods, &MethodDecl{
+			Recv:       &ReceiverDecl{StructType: t.Obj().Name(), IsPointer: valueSet.Lookup(fn.Pkg(), fn.Name()) == nil},
+			Identifier: fn.Name(),
+			Signature:  buf.String(),
+		})
+	}
+	return methods
+}
+
+// fileQualifier qualifies package members by the names the file imports them under,
+// packages the file doesn't import are added to its imports.
+func fileQualifier(fileInfo *SourceFileInfo, current *types.Package) types.Qualifier {
+	return func(pkg *types.Package) string {
+		if pkg == current {
+			return ""
+		}
+		path := strconv.Quote(pkg.Path())
+		for _, imp := range fileInfo.Imports {
+			if imp.Path != path {
+				continue
+			}
+			if imp.Alias == "." {
+				return ""
+			}
+			return importName(imp)
+		}
+		imp := &ImportStmt{Path: path}
+		if importName(imp) != pkg.Name() {
+			imp.Alias = pkg.Name()
+		}
+		fileInfo.Imports = append(fileInfo.Imports, imp)
+		return pkg.Name()
+	}
+}
+
+// leadingComment returns the text between the previous declaration and the
+// method, starting from the line after the previous declaration.
+func leadingComment(between string) string {
+	if i := strings.IndexByte(between, '\n'); i >= 0 {
+		return between[i+1:]
+	}
+	return ""
+}
+
+func receiverDecl(expr ast.Expr) *ReceiverDecl {
+	recv := &ReceiverDecl{}
+	if paren, ok := expr.(*ast.ParenExpr); ok {
+		expr = paren.X
+	}
+	if star, ok := expr.(*ast.StarExpr); ok {
+		recv.IsPointer = true
+		expr = star.X
+	}
+	switch x := expr.(type) {
+	case *ast.IndexExpr:
+		recv.TypeArgs = []string{exprString(x.Index)}
+		expr = x.X
+	case *ast.IndexListExpr:
+		for _, index := range x.Indices {
+			recv.TypeArgs = append(recv.TypeArgs, exprString(index))
+		}
+		expr = x.X
+	}
+	recv.StructType = exprString(expr)
+	return recv
+}
+
+func receiverNamed(fn *types.Func) *types.Named {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return nil
+	}
+	t := recv.Type()
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, _ := t.(*types.Named)
+	return named
+}
+
+func exprString(expr ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, token.NewFileSet(), expr)
+	return buf.String()
+}
+
+// packageDiagnostic converts a go/packages error, positions are file:line:col.
+// invalidFile returns the result of a file without a package clause, e.g. an empty one,
+// which cannot be extracted. It has an error diagnostic even if go/packages reports none.
+func invalidFile(filename string, diagnostics []*Diagnostic) *SourceFileInfo {
+	fileInfo := &SourceFileInfo{FileName: filename, Diagnostics: diagnostics}
+	if !fileInfo.HasErrors() {
+		fileInfo.Diagnostics = append(fileInfo.Diagnostics, &Diagnostic{File: filename, Line: 1, Column: 1, Message: "expected 'package'", Severity: SeverityError})
+	}
+	return fileInfo
+}
+
+func packageDiagnostic(e packages.Error) *Diagnostic {
+	d := &Diagnostic{Message: e.Msg, Severity: SeverityError}
+	if e.Kind == packages.TypeError {
+		// incomplete dependencies must not prevent the extraction
+		d.Severity = SeverityWarning
+	}
+	parts := strings.Split(e.Pos, ":")
+	for len(parts) > 1 {
+		n, err := strconv.Atoi(parts[len(parts)-1])
+		if err != nil {
+			break
+		}
+		d.Line, d.Column = n, d.Line
+		parts = parts[:len(parts)-1]
+	}
+	if d.Column == 0 {
+		d.Column = 1
+	}
+	d.File = strings.Join(parts, ":")
+	return d
+}
@@ -2,15 +2,151 @@ package parser
 
 import (
 	"fmt"
+	"go/ast"
 	"go/format"
+	goparser "go/parser"
+	"go/token"
+	"os"
+	"regexp"
 	"sort"
 	"strings"
+	"text/template"
 )
 
 type InterfaceGenerator struct {
-	Files   []*SourceFileInfo
-	Types   map[string]struct{}
-	PkgName string
+	Files     []*SourceFileInfo
+	Types     map[string]struct{}
+	PkgName   string
+	Promoted  bool // include the methods promoted from embedded fields
+	Receivers ReceiverMode
+	Mock      bool // emit a mock implementation of every interface
+	// Assertions emits compile-time assertions that the types implement their interfaces.
+	Assertions bool
+	// NameTemplate is the text/template of the interface names, executed with an
+	// InterfaceName. DefaultNameTemplate is used if it is empty.
+	NameTemplate string
+	// Names overrides the interface names of some types, the Suffix is still appended.
+	Names map[string]string
+	// Roles split the methods of the types into several interfaces, named after the type
+	// and the role, e.g. UserReader. A method goes to the first role matching its name.
+	Roles []*Role
+	// Compose makes the interfaces of a type embed the ones of its groups and roles.
+	Compose bool
+	// Common lists interfaces with the methods shared by several types. The interfaces of
+	// the types themselves are then only generated for Types.
+	Common []*CommonInterface
+	// Signatures selects how the signatures are written.
+	Signatures SignatureStyle
+	// Outputs are the files the code is written to. Their declarations are replaced, so
+	// they don't collide with the generated ones.
+	Outputs []string
+	// Report receives the warnings of the generation, e.g. the methods left out of a
+	// common interface. They are dropped if it is nil.
+	Report func(*Diagnostic)
+}
+
+// Role is a role-based interface of the types, with the methods whose name matches Pattern.
+type Role struct {
+	Name    string
+	Pattern *regexp.Regexp
+}
+
+// ParseRole parses a role of the form Name=regexp, e.g. Reader=^(Get|List).
+func ParseRole(s string) (*Role, error) {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid role %q, expected Name=regexp", s)
+	}
+	name := s[:i]
+	if !token.IsIdentifier(name) {
+		return nil, fmt.Errorf("role name %q is not an identifier", name)
+	}
+	pattern, err := regexp.Compile(s[i+1:])
+	if err != nil {
+		return nil, fmt.Errorf("role %s: %w", name, err)
+	}
+	return &Role{Name: name, Pattern: pattern}, nil
+}
+
+// DefaultNameTemplate names the interfaces I{Type} and I{Type}Value.
+const DefaultNameTemplate = "I{{.Type}}{{.Suffix}}"
+
+// InterfaceName is the data of the interface name template.
+type InterfaceName struct {
+	Type     string // name of the type
+	Receiver string // pointer if the interface is implemented by *Type, value otherwise
+	Suffix   string // Value for the value receiver interface of a type that has both interfaces
+	Package  string // package name of the type
+}
+
+// ReceiverMode selects how the methods of a type are split into interfaces by receiver.
+type ReceiverMode int
+
+const (
+	// SplitReceivers puts the pointer receiver methods in I{Type} and the value receiver
+	// methods in I{Type}Value.
+	SplitReceivers ReceiverMode = iota
+	// PointerSet puts the method set of *Type in I{Type} and the method set of Type in I{Type}Value.
+	PointerSet
+	// ValueOnly puts the method set of Type in I{Type}, pointer receiver methods are left out.
+	ValueOnly
+)
+
+func (m ReceiverMode) String() string {
+	switch m {
+	case SplitReceivers:
+		return "split"
+	case PointerSet:
+		return "pointer-set"
+	case ValueOnly:
+		return "value-only"
+	}
+	return fmt.Sprintf("ReceiverMode(%d)", int(m))
+}
+
+// ParseReceiverMode parses the name of a receiver mode, split, pointer-set or value-only.
+func ParseReceiverMode(s string) (ReceiverMode, error) {
+	for _, m := range []ReceiverMode{SplitReceivers, PointerSet, ValueOnly} {
+		if m.String() == s {
+			return m, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown receiver mode %q, expected split, pointer-set or value-only", s)
+}
+
+// SignatureStyle selects how the signatures of the methods are written in the interfaces.
+type SignatureStyle int
+
+const (
+	// SourceSignatures writes the signatures as they are in the sources.
+	SourceSignatures SignatureStyle = iota
+	// CanonicalSignatures renders the signatures with the spacing of gofmt, on one line,
+	// and the consecutive parameters of the same type grouped, e.g. (a, b int).
+	CanonicalSignatures
+	// UnnamedSignatures renders the signatures canonically without the parameter names.
+	UnnamedSignatures
+)
+
+func (s SignatureStyle) String() string {
+	switch s {
+	case SourceSignatures:
+		return "source"
+	case CanonicalSignatures:
+		return "canonical"
+	case UnnamedSignatures:
+		return "unnamed"
+	}
+	return fmt.Sprintf("SignatureStyle(%d)", int(s))
+}
+
+// ParseSignatureStyle parses the name of a signature style, source, canonical or unnamed.
+func ParseSignatureStyle(s string) (SignatureStyle, error) {
+	for _, style := range []SignatureStyle{SourceSignatures, CanonicalSignatures, UnnamedSignatures} {
+		if style.String() == s {
+			return style, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown signature style %q, expected source, canonical or unnamed", s)
 }
 
 type interfaceRepr struct {
@@ -18,15 +154,141 @@ type interfaceRepr struct {
 	PointerRecvMeth []*MethodDecl
 }
 
+// boundMethod is a method in the method set of recvType, file is the index of the
+// file the signature was taken from.
+type boundMethod struct {
+	decl      *MethodDecl
+	file      int
+	recvType  string
+	isPointer bool
+}
+
+// typeEntry is a type declaration and the index of the file declaring it.
+type typeEntry struct {
+	decl *TypeDecl
+	file int
+}
+
+// interfaceDecl is an interface to generate for the methods of TypeName.
+type interfaceDecl struct {
+	Name       string
+	TypeName   string
+	TypeParams string   // type parameter list, e.g. [K comparable, V any]
+	TypeArgs   []string // type parameter names, e.g. [K, V]
+	IsPointer  bool     // implemented by *TypeName rather than TypeName
+	Methods    []*MethodDecl
+	Embeds     []*interfaceDecl // interfaces of the groups, embedded by a composed interface
+	Types      []string         // types of a common interface, TypeName is empty
+}
+
+// implementers returns the types implementing the interface.
+func (iface *interfaceDecl) implementers() []string {
+	if len(iface.Types) != 0 {
+		return iface.Types
+	}
+	return []string{iface.TypeName}
+}
+
+// instance returns the name of the interface instantiated with its type parameters, e.g. IMap[K, V].
+func (iface *interfaceDecl) instance() string {
+	if len(iface.TypeArgs) == 0 {
+		return iface.Name
+	}
+	return iface.Name + "[" + strings.Join(iface.TypeArgs, ", ") + "]"
+}
+
+// allMethods returns the methods of the interface, the embedded ones included.
+func (iface *interfaceDecl) allMethods() []*MethodDecl {
+	var methods []*MethodDecl
+	for _, e := range iface.Embeds {
+		methods = append(methods, e.allMethods()...)
+	}
+	return append(methods, iface.Methods...)
+}
+
 func (gen *InterfaceGenerator) GenerateCode() (string, error) {
 	if len(gen.Files) == 0 {
 		return "", nil
 	}
+	pkgName, err := gen.packageName()
+	if err != nil {
+		return "", err
+	}
+	imports, ifaces, err := gen.interfaces(pkgName)
+	if err != nil {
+		return "", err
+	}
+
 	sb := strings.Builder{}
 	// emit comment
 	sb.WriteString(COMMENT)
 
-	// check the package name and emit package statement
+	// emit package statement
+	sb.WriteString("package ")
+	sb.WriteString(pkgName)
+	sb.WriteString("\n")
+
+	// emit imports referenced by the selected methods, conflicting aliases are renamed
+	if gen.Mock && len(ifaces) != 0 {
+		imports = append(imports, mockImports...)
+	}
+	srcQualifier := ""
+	if gen.Assertions && len(ifaces) != 0 && pkgName != gen.Files[0].PkgName {
+		// the types are in another package
+		imp, err := gen.sourceImport(imports)
+		if err != nil {
+			return "", err
+		}
+		imports = append(imports, imp)
+		srcQualifier = importName(imp) + "."
+	}
+	emitImports(&sb, imports)
+
+	// emit interfaces
+	for _, iface := range ifaces {
+		emitInterface(&sb, iface)
+	}
+	if gen.Assertions {
+		emitAssertions(&sb, ifaces, srcQualifier)
+	}
+	if gen.Mock {
+		for _, iface := range ifaces {
+			if err := emitMock(&sb, iface); err != nil {
+				return "", err
+			}
+		}
+	}
+
+	rawCode := sb.String()
+	// fmt.Println(rawCode)
+	// format interface code
+	code, err := format.Source([]byte(rawCode))
+	return string(code), err
+}
+
+// InterfaceNames returns the names of the interfaces GenerateCode generates.
+func (gen *InterfaceGenerator) InterfaceNames() ([]string, error) {
+	if len(gen.Files) == 0 {
+		return nil, nil
+	}
+	pkgName, err := gen.packageName()
+	if err != nil {
+		return nil, err
+	}
+	_, ifaces, err := gen.interfaces(pkgName)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(ifaces))
+	for _, iface := range ifaces {
+		names = append(names, iface.Name)
+	}
+	return names, nil
+}
+
+// packageName returns the package name of the output, the files must be in the same package
+// unless the name is given.
+func (gen *InterfaceGenerator) packageName() (string, error) {
 	pkgName := gen.PkgName
 	if pkgName == "" {
 		pkgName = gen.Files[0].PkgName
@@ -36,36 +298,64 @@ func (gen *InterfaceGenerator) GenerateCode() (string, error) {
 			}
 		}
 	}
+	return pkgName, nil
+}
 
-	sb.WriteString("package ")
-	sb.WriteString(pkgName)
-	sb.WriteString("\n")
+// interfaces returns the interfaces of the selected types sorted by type name, and the
+// imports their signatures refer to. If pkgName, the package of the output, is not the
+// package of the types, their identifiers are qualified and the package is imported.
+func (gen *InterfaceGenerator) interfaces(pkgName string) ([]*ImportStmt, []*interfaceDecl, error) {
+	nameTemplate := gen.NameTemplate
+	if nameTemplate == "" {
+		nameTemplate = DefaultNameTemplate
+	}
+	tmpl, err := template.New("name").Parse(nameTemplate)
+	if err != nil {
+		return nil, nil, fmt.Errorf("interface name template: %w", err)
+	}
 
-	// emit imports
-	imports := make([]*ImportStmt, 0)
-	for _, f := range gen.Files {
-		imports = append(imports, f.Imports...)
+	var q *qualification
+	typeEntries, methods := gen.collectMethods()
+	if pkgName != gen.Files[0].PkgName {
+		q = gen.newQualification()
+		methods = q.exportable(gen.emitted(methods, typeEntries))
+	}
+	commons, err := gen.commonMethods(typeEntries, methods)
+	if err != nil {
+		return nil, nil, err
+	}
+	imports, renames := resolveImports(gen.usedImports(typeEntries, methods, commons))
+	if q != nil {
+		q.name = gen.sourceName(imports)
 	}
-	emitImports(&sb, imports)
 
-	// emit interfaces
-	structMap := map[string]*interfaceRepr{}
-	for _, f := range gen.Files {
-		for _, m := range f.Methods {
-			recv := m.Recv
-			tp := recv.StructType
-			repr, ok := structMap[tp]
-			if !ok {
-				repr = &interfaceRepr{}
-				structMap[tp] = repr
-			}
-			if recv.IsPointer {
-				repr.PointerRecvMeth = append(repr.PointerRecvMeth, m)
-			} else {
-				repr.ValueRecvMeth = append(repr.ValueRecvMeth, m)
+	typeDecls := make(map[string]*TypeDecl, len(typeEntries))
+	for name, t := range typeEntries {
+		typeDecls[name] = renameTypeDecl(t.decl, renames[t.file])
+		if q != nil && gen.selected(name, typeEntries) {
+			if typeDecls[name], err = q.typeDecl(typeDecls[name]); err != nil {
+				return nil, nil, err
 			}
 		}
 	}
+	structMap := map[string]*interfaceRepr{}
+	for _, bm := range methods {
+		m := renameMethod(bm.decl, renames[bm.file])
+		if q != nil {
+			m = q.method(m)
+		}
+		tp := bm.recvType
+		repr, ok := structMap[tp]
+		if !ok {
+			repr = &interfaceRepr{}
+			structMap[tp] = repr
+		}
+		if bm.isPointer {
+			repr.PointerRecvMeth = append(repr.PointerRecvMeth, m)
+		} else {
+			repr.ValueRecvMeth = append(repr.ValueRecvMeth, m)
+		}
+	}
 
 	tps := make([]string, 0, len(structMap))
 	for tp := range structMap {
@@ -73,73 +363,539 @@ func (gen *InterfaceGenerator) GenerateCode() (string, error) {
 	}
 	sort.Strings(tps)
 
+	var ifaces []*interfaceDecl
 	for _, typeName := range tps {
 		repr := structMap[typeName]
-		if gen.Types != nil {
-			// check if typeName is in gen.Types
-			if _, ok := gen.Types[typeName]; !ok {
-				continue // skip
+		if !gen.selected(typeName, typeEntries) {
+			continue // skip
+		}
+		typeArgs := typeParamNames(typeName, typeDecls[typeName], repr)
+		if err := renameReceiverParams(repr, typeName, typeArgs); err != nil {
+			return nil, nil, err
+		}
+		var nameErr error
+		newInterface := func(group, suffix string, isPointer bool, methods []*MethodDecl) *interfaceDecl {
+			sort.Slice(methods, func(i, j int) bool { return methods[i].Identifier < methods[j].Identifier })
+			name, err := gen.interfaceName(tmpl, typeDecls[typeName], typeName, group, suffix, isPointer)
+			if err != nil {
+				nameErr = err
+			}
+			return &interfaceDecl{
+				Name:       name,
+				TypeName:   typeName,
+				TypeParams: emitTypeParams(typeArgs, typeDecls[typeName]),
+				TypeArgs:   typeArgs,
+				IsPointer:  isPointer,
+				Methods:    methods,
+			}
+		}
+
+		// the methods of a group or a role have an interface of their own
+		groups := map[string]*interfaceRepr{}
+		for _, m := range repr.PointerRecvMeth {
+			g := gen.methodGroup(groups, typeName, m)
+			g.PointerRecvMeth = append(g.PointerRecvMeth, m)
+		}
+		for _, m := range repr.ValueRecvMeth {
+			g := gen.methodGroup(groups, typeName, m)
+			g.ValueRecvMeth = append(g.ValueRecvMeth, m)
+		}
+		groupNames := make([]string, 0, len(groups))
+		for group := range groups {
+			groupNames = append(groupNames, group)
+		}
+		sort.Strings(groupNames) // the interface of the type first
+
+		start := len(ifaces)
+		var ownPointer, ownValue *interfaceDecl
+		var embedPointer, embedValue []*interfaceDecl
+		for _, group := range groupNames {
+			g := groups[group]
+			both := len(g.PointerRecvMeth) != 0 && len(g.ValueRecvMeth) != 0
+			if group == "" && gen.Compose {
+				// named like the composed interfaces
+				both = len(repr.PointerRecvMeth) != 0 && len(repr.ValueRecvMeth) != 0
+			}
+			pointerMeth := g.PointerRecvMeth
+			if gen.Receivers == PointerSet {
+				// the method set of *T includes the methods of T
+				pointerMeth = make([]*MethodDecl, 0, len(g.PointerRecvMeth)+len(g.ValueRecvMeth))
+				pointerMeth = append(pointerMeth, g.PointerRecvMeth...)
+				pointerMeth = append(pointerMeth, g.ValueRecvMeth...)
+			}
+			var pointerIface, valueIface *interfaceDecl
+			if len(g.PointerRecvMeth) != 0 {
+				pointerIface = newInterface(group, "", true, pointerMeth)
+				ifaces = append(ifaces, pointerIface)
+			}
+			if len(g.ValueRecvMeth) != 0 {
+				suffix := ""
+				if both {
+					suffix = "Value"
+				}
+				valueIface = newInterface(group, suffix, false, g.ValueRecvMeth)
+				ifaces = append(ifaces, valueIface)
+			}
+
+			if group == "" {
+				ownPointer, ownValue = pointerIface, valueIface
+				continue
+			}
+			if pointerIface != nil {
+				embedPointer = append(embedPointer, pointerIface)
+			} else if gen.Receivers == PointerSet && len(repr.PointerRecvMeth) != 0 {
+				embedPointer = append(embedPointer, valueIface)
+			}
+			if valueIface != nil {
+				embedValue = append(embedValue, valueIface)
+			}
+		}
+
+		if gen.Compose && len(embedPointer)+len(embedValue) != 0 {
+			// the interfaces of the type embed the ones of the groups, they are created
+			// if all the methods are in groups
+			if len(embedPointer) != 0 {
+				if ownPointer == nil {
+					ownPointer = newInterface("", "", true, nil)
+				}
+				ownPointer.Embeds = embedPointer
+			}
+			if len(embedValue) != 0 {
+				if ownValue == nil {
+					suffix := ""
+					if len(repr.PointerRecvMeth) != 0 {
+						suffix = "Value"
+					}
+					ownValue = newInterface("", suffix, false, nil)
+				}
+				ownValue.Embeds = embedValue
+			}
+			groupIfaces := append([]*interfaceDecl(nil), ifaces[start:]...)
+			ifaces = ifaces[:start]
+			for _, iface := range []*interfaceDecl{ownPointer, ownValue} {
+				if iface != nil {
+					ifaces = append(ifaces, iface)
+				}
 			}
+			for _, iface := range groupIfaces {
+				if iface != ownPointer && iface != ownValue {
+					ifaces = append(ifaces, iface)
+				}
+			}
+		}
+		if nameErr != nil {
+			return nil, nil, nameErr
+		}
+	}
+	for _, set := range commons {
+		iface := &interfaceDecl{Name: set.common.Name, Types: set.common.Types, IsPointer: gen.Receivers != ValueOnly}
+		for _, bm := range set.methods {
+			m := renameMethod(bm.decl, renames[bm.file])
+			if q != nil {
+				m = q.method(m)
+			}
+			iface.Methods = append(iface.Methods, m)
 		}
-		if len(repr.PointerRecvMeth) != 0 && len(repr.ValueRecvMeth) != 0 {
-			emitInterface(&sb, fmt.Sprintf("I%s", typeName), repr.PointerRecvMeth)
-			emitInterface(&sb, fmt.Sprintf("I%sValue", typeName), repr.ValueRecvMeth)
-		} else if len(repr.PointerRecvMeth) != 0 {
-			emitInterface(&sb, fmt.Sprintf("I%s", typeName), repr.PointerRecvMeth)
-		} else if len(repr.ValueRecvMeth) != 0 {
-			emitInterface(&sb, fmt.Sprintf("I%s", typeName), repr.ValueRecvMeth)
+		ifaces = append(ifaces, iface)
+	}
+	if err := gen.checkNames(ifaces); err != nil {
+		return nil, nil, err
+	}
+	if q != nil && q.used {
+		imp, err := gen.sourceImport(imports)
+		if err != nil {
+			return nil, nil, err
 		}
+		imports = append(imports, imp)
 	}
+	return imports, ifaces, nil
+}
 
-	rawCode := sb.String()
-	// fmt.Println(rawCode)
-	// format interface code
-	code, err := format.Source([]byte(rawCode))
-	return string(code), err
+// interfaceName returns the name of an interface of typeName. The interface of a group
+// is named after the group, the one of the type after gen.Names, the annotation of the
+// type or the template, in that order.
+func (gen *InterfaceGenerator) interfaceName(tmpl *template.Template, decl *TypeDecl, typeName, group, suffix string, isPointer bool) (string, error) {
+	if group != "" {
+		return group + suffix, nil
+	}
+	if name, ok := gen.Names[typeName]; ok {
+		return name + suffix, nil
+	}
+	if decl != nil && decl.Name != "" {
+		return decl.Name + suffix, nil
+	}
+	data := InterfaceName{Type: typeName, Receiver: "value", Suffix: suffix, Package: gen.Files[0].PkgName}
+	if isPointer {
+		data.Receiver = "pointer"
+	}
+	sb := strings.Builder{}
+	if err := tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("interface name of %s: %w", typeName, err)
+	}
+	return sb.String(), nil
+}
+
+// methodGroup returns the methods of the group of m in groups: the group of its annotation,
+// or the first role matching its name, named after typeName. The annotations of promoted
+// methods belong to the embedded types, they are ignored.
+func (gen *InterfaceGenerator) methodGroup(groups map[string]*interfaceRepr, typeName string, m *MethodDecl) *interfaceRepr {
+	group := ""
+	if m.Recv.StructType == typeName {
+		group = m.Group
+	}
+	if group == "" {
+		for _, r := range gen.Roles {
+			if r.Pattern.MatchString(m.Identifier) {
+				group = typeName + r.Name
+				break
+			}
+		}
+	}
+	g, ok := groups[group]
+	if !ok {
+		g = &interfaceRepr{}
+		groups[group] = g
+	}
+	return g
+}
+
+// checkNames reports interface names that are not identifiers, that are used twice or,
+// if the output is in the package of the types, that are declared in the package.
+// Declarations of the files of gen.Outputs don't count, they are being replaced.
+func (gen *InterfaceGenerator) checkNames(ifaces []*interfaceDecl) error {
+	names := map[string]string{}
+	for _, iface := range ifaces {
+		owner := strings.Join(iface.implementers(), ", ")
+		if !token.IsIdentifier(iface.Name) {
+			return fmt.Errorf("interface name %q of %s is not an identifier", iface.Name, owner)
+		}
+		if other, ok := names[iface.Name]; ok {
+			return fmt.Errorf("interface name %s is used for both %s and %s", iface.Name, other, owner)
+		}
+		names[iface.Name] = owner
+	}
+
+	if pkgName, err := gen.packageName(); err != nil || pkgName != gen.Files[0].PkgName {
+		return nil
+	}
+	for _, f := range gen.Files {
+		if gen.isOutput(f.FileName) {
+			continue
+		}
+		declared := f.Decls
+		for _, t := range f.Types {
+			declared = append(declared, t.Identifier)
+		}
+		for _, ident := range declared {
+			if typeName, ok := names[ident]; ok {
+				return fmt.Errorf("interface name %s of %s collides with %s declared in %s", ident, typeName, ident, f.FileName)
+			}
+		}
+	}
+	return nil
 }
 
-func emitInterface(sb *strings.Builder, name string, methods []*MethodDecl) {
-	sb.WriteString(fmt.Sprintf("type %s interface {\n\n", name))
+// isOutput reports whether filename is one of gen.Outputs.
+func (gen *InterfaceGenerator) isOutput(filename string) bool {
+	fi, err := os.Stat(filename)
+	if err != nil {
+		return false
+	}
+	for _, output := range gen.Outputs {
+		if other, err := os.Stat(output); err == nil && os.SameFile(fi, other) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectMethods returns the type declarations of the files and the methods of every
+// receiver type, including the promoted ones if gen.Promoted is set. The files generated
+// by gointerface are left out, their interfaces and mocks are the output, not the input.
+func (gen *InterfaceGenerator) collectMethods() (map[string]*typeEntry, []*boundMethod) {
+	typeEntries := map[string]*typeEntry{}
+	direct := map[string][]*boundMethod{}
+	var methods []*boundMethod
+	for i, f := range gen.Files {
+		if f.Generated {
+			continue
+		}
+		for _, t := range f.Types {
+			typeEntries[t.Identifier] = &typeEntry{decl: t, file: i}
+		}
+		for _, m := range f.Methods {
+			bm := &boundMethod{decl: m, file: i, recvType: m.Recv.StructType, isPointer: m.Recv.IsPointer}
+			direct[bm.recvType] = append(direct[bm.recvType], bm)
+			methods = append(methods, bm)
+		}
+	}
+	if gen.Promoted {
+		// types without methods of their own may still have promoted methods
+		names := make([]string, 0, len(typeEntries))
+		for name := range typeEntries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			methods = append(methods, promotedMethods(name, typeEntries, direct)...)
+		}
+	}
+	if gen.Signatures != SourceSignatures {
+		opts := GroupParams
+		if gen.Signatures == UnnamedSignatures {
+			opts = StripNames
+		}
+		for _, m := range methods {
+			rendered := *m.decl
+			rendered.Signature = canonicalSignature(m.decl, opts)
+			m.decl = &rendered
+		}
+	}
+	if gen.Receivers == ValueOnly {
+		valueMethods := methods[:0]
+		for _, m := range methods {
+			if !m.isPointer {
+				valueMethods = append(valueMethods, m)
+			}
+		}
+		methods = valueMethods
+	}
+	return typeEntries, methods
+}
 
-	sort.Slice(methods, func(i, j int) bool { return methods[i].Identifier < methods[j].Identifier })
+// emitted returns the methods of the selected types and of the types of the common
+// interfaces, the other ones are not written.
+func (gen *InterfaceGenerator) emitted(methods []*boundMethod, typeEntries map[string]*typeEntry) []*boundMethod {
+	common := map[string]struct{}{}
+	for _, c := range gen.Common {
+		for _, t := range c.Types {
+			common[t] = struct{}{}
+		}
+	}
+	result := make([]*boundMethod, 0, len(methods))
 	for _, m := range methods {
-		emitMethod(sb, m)
+		if _, ok := common[m.recvType]; ok || gen.selected(m.recvType, typeEntries) {
+			result = append(result, m)
+		}
 	}
-	sb.WriteString("}\n\n")
+	return result
 }
 
-func emitImports(sb *strings.Builder, imp []*ImportStmt) {
-	if len(imp) == 0 {
-		return
+// selected reports whether the interface of typeName is generated. Without gen.Types,
+// the types annotated with //gointerface:exclude are left out, and all of them if common
+// interfaces are generated.
+func (gen *InterfaceGenerator) selected(typeName string, typeEntries map[string]*typeEntry) bool {
+	if gen.Types == nil {
+		if len(gen.Common) != 0 {
+			return false
+		}
+		t, ok := typeEntries[typeName]
+		return !ok || !t.decl.Exclude
+	}
+	_, ok := gen.Types[typeName]
+	return ok
+}
+
+// typeParamNames returns the type parameter names of a generic type, the ones of its
+// declaration, or of its first receiver if the declaration is not in the input files.
+func typeParamNames(typeName string, decl *TypeDecl, repr *interfaceRepr) []string {
+	if decl != nil && len(decl.TypeParams) != 0 {
+		names := make([]string, 0, len(decl.TypeParams))
+		for _, p := range decl.TypeParams {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+	for _, m := range append(repr.PointerRecvMeth, repr.ValueRecvMeth...) {
+		if m.Recv.StructType == typeName && len(m.Recv.TypeArgs) != 0 {
+			return m.Recv.TypeArgs
+		}
+	}
+	return nil
+}
+
+// renameReceiverParams renames the type parameters of the methods of typeName to names.
+// Each method may name them freely, e.g. func (l *List[E]) Pop() E of type List[T any]
+// becomes Pop() T. The methods are replaced, not modified.
+func renameReceiverParams(repr *interfaceRepr, typeName string, names []string) error {
+	for _, methods := range [][]*MethodDecl{repr.PointerRecvMeth, repr.ValueRecvMeth} {
+		for i, m := range methods {
+			if m.Recv.StructType != typeName || len(m.Recv.TypeArgs) != len(names) {
+				continue // promoted from an embedded type
+			}
+			renamed, err := renameTypeParams(m, names)
+			if err != nil {
+				return err
+			}
+			methods[i] = renamed
+		}
+	}
+	return nil
+}
+
+// renameTypeParams returns m with the type parameters of its receiver renamed to names.
+func renameTypeParams(m *MethodDecl, names []string) (*MethodDecl, error) {
+	renames := map[string]string{}
+	recvNames := map[string]struct{}{}
+	targets := map[string]struct{}{}
+	for i, arg := range m.Recv.TypeArgs {
+		arg = strings.TrimSpace(arg)
+		recvNames[arg] = struct{}{}
+		targets[names[i]] = struct{}{}
+		if arg != names[i] && arg != "_" {
+			renames[arg] = names[i]
+		}
+	}
+	if len(renames) == 0 {
+		return m, nil
+	}
+
+	fset := token.NewFileSet()
+	const prefix = "func"
+	expr, err := goparser.ParseExprFrom(fset, "", prefix+m.Signature, 0)
+	if err != nil {
+		return nil, fmt.Errorf("method %s.%s: %w", m.Recv.StructType, m.Identifier, err)
+	}
+	type occurrence struct {
+		offset int
+		ident  *ast.Ident
+	}
+	var occurrences []occurrence
+	var conflict string
+	var visit func(n ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			ast.Inspect(n.Type, visit) // the names of parameters are not types
+			return false
+		case *ast.SelectorExpr:
+			if _, ok := n.X.(*ast.Ident); ok {
+				return false // qualified identifier of another package
+			}
+		case *ast.Ident:
+			if _, ok := renames[n.Name]; ok {
+				occurrences = append(occurrences, occurrence{fset.Position(n.Pos()).Offset - len(prefix), n})
+			} else if _, ok := targets[n.Name]; ok {
+				if _, ok := recvNames[n.Name]; !ok {
+					conflict = n.Name
+				}
+			}
+		}
+		return true
 	}
+	ast.Inspect(expr, visit)
+	if conflict != "" {
+		return nil, fmt.Errorf("method %s.%s: its type parameters cannot be renamed to the ones of %s, the signature refers to another %s",
+			m.Recv.StructType, m.Identifier, m.Recv.StructType, conflict)
+	}
+
+	sort.Slice(occurrences, func(i, j int) bool { return occurrences[i].offset < occurrences[j].offset })
+	sb := strings.Builder{}
+	last := 0
+	for _, o := range occurrences {
+		sb.WriteString(m.Signature[last:o.offset])
+		sb.WriteString(renames[o.ident.Name])
+		last = o.offset + len(o.ident.Name)
+	}
+	sb.WriteString(m.Signature[last:])
 
-	// make unique
-	importsMap := make(map[ImportStmt]struct{})
-	for _, i := range imp {
-		importsMap[*i] = struct{}{}
+	renamed := *m
+	renamed.Signature = sb.String()
+	renamed.Sig = nil
+	recv := *m.Recv
+	recv.TypeArgs = names
+	renamed.Recv = &recv
+	return &renamed, nil
+}
+
+// emitTypeParams renders the type parameter list of a generic type, e.g. [K comparable, V any].
+// The constraints come from the type declaration.
+func emitTypeParams(names []string, decl *TypeDecl) string {
+	if len(names) == 0 {
+		return ""
 	}
 
-	// sort
-	sortedImports := make([]ImportStmt, 0, len(importsMap))
-	for i := range importsMap {
-		sortedImports = append(sortedImports, i)
+	constraints := make([]string, len(names))
+	for i := range names {
+		constraints[i] = "any" // the declaration is not in the input files
+		if decl != nil && i < len(decl.TypeParams) {
+			constraints[i] = decl.TypeParams[i].Constraint
+		}
 	}
 
-	sort.Slice(sortedImports, func(i, j int) bool {
-		if sortedImports[i].Path == sortedImports[j].Path {
-			return sortedImports[i].Alias < sortedImports[j].Alias
+	sb := strings.Builder{}
+	sb.WriteString("[")
+	for i, name := range names {
+		sb.WriteString(name)
+		// K, V any
+		if i+1 < len(names) && constraints[i] == constraints[i+1] {
+			sb.WriteString(", ")
+			continue
+		}
+		sb.WriteString(" ")
+		sb.WriteString(constraints[i])
+		if i+1 < len(names) {
+			sb.WriteString(", ")
+		}
+	}
+	sb.WriteString("]")
+	return sb.String()
+}
+
+// emitAssertions emits an assignment of every type to its interface, so that the output
+// doesn't compile once they drift apart. Generic types are asserted in a generic function
+// as they cannot be instantiated here.
+func emitAssertions(sb *strings.Builder, ifaces []*interfaceDecl, qualifier string) {
+	if len(ifaces) == 0 {
+		return
+	}
+	sb.WriteString("// compile-time assertions that the types implement their interfaces\n")
+	sb.WriteString("var (\n")
+	for _, iface := range ifaces {
+		if len(iface.TypeArgs) == 0 {
+			for _, typeName := range iface.implementers() {
+				sb.WriteString(fmt.Sprintf("_ %s = %s\n", iface.Name, assertedValue(qualifier+typeName, iface.IsPointer)))
+			}
+		}
+	}
+	sb.WriteString(")\n\n")
+	for _, iface := range ifaces {
+		if len(iface.TypeArgs) != 0 {
+			typeArgs := "[" + strings.Join(iface.TypeArgs, ", ") + "]"
+			sb.WriteString(fmt.Sprintf("func _%s() {\n", iface.TypeParams))
+			sb.WriteString(fmt.Sprintf("var _ %s = %s\n", iface.instance(), assertedValue(qualifier+iface.TypeName+typeArgs, iface.IsPointer)))
+			sb.WriteString("}\n\n")
 		}
-		return sortedImports[i].Path < sortedImports[j].Path
-	})
+	}
+}
 
-	sb.WriteString("import (\n")
-	for _, i := range imp {
-		sb.WriteString(fmt.Sprintf("%s %s\n", i.Alias, i.Path))
+// assertedValue returns a value of typeName, or of *typeName, that works for any kind of type.
+func assertedValue(typeName string, isPointer bool) string {
+	if isPointer {
+		return fmt.Sprintf("(*%s)(nil)", typeName)
 	}
-	sb.WriteString(")\n")
+	return fmt.Sprintf("*new(%s)", typeName)
+}
+
+func emitInterface(sb *strings.Builder, iface *interfaceDecl) {
+	sb.WriteString(fmt.Sprintf("type %s%s interface {\n", iface.Name, iface.TypeParams))
+	for _, e := range iface.Embeds {
+		sb.WriteString(e.instance())
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	for _, m := range iface.Methods {
+		emitMethod(sb, m)
+	}
+	sb.WriteString("}\n\n")
 }
 
 func emitMethod(sb *strings.Builder, m *MethodDecl) {
+	if m.Guard != "" {
+		sb.WriteString(fmt.Sprintf("\n// %s%s is only available on %s.\n", m.Identifier, strings.Join(strings.Fields(m.Signature), " "), m.Guard))
+		return
+	}
 	sb.WriteString("\n")
 	sb.WriteString(m.Comment)
 	sb.WriteString(m.Identifier)
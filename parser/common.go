@@ -0,0 +1,128 @@
+package parser
+
+import (
+	"fmt"
+	"go/token"
+	"sort"
+	"strings"
+)
+
+// CommonInterface is an interface with the methods shared by several types.
+type CommonInterface struct {
+	Name  string
+	Types []string
+}
+
+// ParseCommon parses a common interface of the form Name=TypeA,TypeB.
+func ParseCommon(s string) (*CommonInterface, error) {
+	i := strings.IndexByte(s, '=')
+	if i < 0 {
+		return nil, fmt.Errorf("invalid common interface %q, expected Name=TypeA,TypeB", s)
+	}
+	c := &CommonInterface{Name: s[:i], Types: strings.Split(s[i+1:], ",")}
+	if !token.IsIdentifier(c.Name) {
+		return nil, fmt.Errorf("common interface name %q is not an identifier", c.Name)
+	}
+	if len(c.Types) < 2 {
+		return nil, fmt.Errorf("common interface %s needs at least two types", c.Name)
+	}
+	for _, t := range c.Types {
+		if !token.IsIdentifier(t) {
+			return nil, fmt.Errorf("type %q of common interface %s is not an identifier", t, c.Name)
+		}
+	}
+	return c, nil
+}
+
+// commonSet is a common interface and the methods it has, taken from its first type.
+type commonSet struct {
+	common  *CommonInterface
+	methods []*boundMethod
+}
+
+// commonMethods returns the methods every type of the common interfaces has with the same
+// signature. Methods with the same name but different signatures are left out and reported.
+func (gen *InterfaceGenerator) commonMethods(typeEntries map[string]*typeEntry, methods []*boundMethod) ([]*commonSet, error) {
+	byType := map[string]map[string]*boundMethod{}
+	for _, m := range methods {
+		if m.decl.Guard != "" {
+			continue // not available everywhere
+		}
+		set, ok := byType[m.recvType]
+		if !ok {
+			set = map[string]*boundMethod{}
+			byType[m.recvType] = set
+		}
+		set[m.decl.Identifier] = m
+	}
+
+	var sets []*commonSet
+	for _, c := range gen.Common {
+		for _, t := range c.Types {
+			entry, declared := typeEntries[t]
+			if _, ok := byType[t]; !ok && !declared {
+				return nil, fmt.Errorf("type %s of common interface %s is not found", t, c.Name)
+			}
+			if declared && len(entry.decl.TypeParams) != 0 {
+				return nil, fmt.Errorf("type %s of common interface %s is generic", t, c.Name)
+			}
+		}
+
+		first := byType[c.Types[0]]
+		names := make([]string, 0, len(first))
+		for name := range first {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		set := &commonSet{common: c}
+		for _, name := range names {
+			m := first[name]
+			shared := true
+			for _, t := range c.Types[1:] {
+				other, ok := byType[t][name]
+				if !ok {
+					shared = false
+					continue
+				}
+				if gen.signatureKey(other) != gen.signatureKey(m) {
+					shared = false
+					gen.report(&Diagnostic{
+						File: gen.Files[other.file].FileName, Line: other.decl.Line, Column: 1, Severity: SeverityWarning,
+						Message: fmt.Sprintf("method %s.%s%s differs from %s.%s%s, it is left out of %s",
+							t, name, canonicalSignature(other.decl, GroupParams),
+							c.Types[0], name, canonicalSignature(m.decl, GroupParams), c.Name),
+					})
+				}
+			}
+			if shared {
+				set.methods = append(set.methods, m)
+			}
+		}
+		if len(set.methods) == 0 {
+			return nil, fmt.Errorf("the types of common interface %s share no methods", c.Name)
+		}
+		sets = append(sets, set)
+	}
+	return sets, nil
+}
+
+// signatureKey returns the canonical signature of m without the parameter names and with
+// its package qualifiers replaced by the import paths, so that files importing a package
+// under different names compare equal.
+func (gen *InterfaceGenerator) signatureKey(m *boundMethod) string {
+	paths := map[string]string{}
+	for _, imp := range gen.Files[m.file].Imports {
+		if imp.Alias != "." {
+			paths[importName(imp)] = imp.Path
+		}
+	}
+	return renameQualifiers(canonicalSignature(m.decl, StripNames), paths)
+}
+
+// report passes d to gen.Report, if it is set.
+func (gen *InterfaceGenerator) report(d *Diagnostic) {
+	if gen.Report != nil {
+		gen.Report(d)
+	}
+}
@@ -0,0 +1,62 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+type Severity int
+
+const (
+	SeverityError Severity = iota
+	SeverityWarning
+)
+
+func (s Severity) String() string {
+	switch s {
+	case SeverityError:
+		return "error"
+	case SeverityWarning:
+		return "warning"
+	}
+	return fmt.Sprintf("Severity(%d)", int(s))
+}
+
+// Diagnostic is a problem found at a position of a source file.
+type Diagnostic struct {
+	File     string
+	Line     int
+	Column   int // 1-based
+	Message  string
+	Severity Severity
+}
+
+// String formats the diagnostic as file:line:col: message.
+func (d *Diagnostic) String() string {
+	if d.Severity == SeverityWarning {
+		return fmt.Sprintf("%s:%d:%d: warning: %s", d.File, d.Line, d.Column, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.File, d.Line, d.Column, d.Message)
+}
+
+// ErrorListener collects the syntax errors of a file as diagnostics.
+type ErrorListener struct {
+	File        string
+	Diagnostics []*Diagnostic
+}
+
+func NewErrorListener(file string) *ErrorListener {
+	return &ErrorListener{File: file}
+}
+
+func (l *ErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	// antlr columns are 0-based
+	l.Diagnostics = append(l.Diagnostics, &Diagnostic{File: l.File, Line: line, Column: column + 1, Message: msg, Severity: SeverityError})
+}
+func (l *ErrorListener) ReportAmbiguity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, exact bool, ambigAlts *antlr.BitSet, configs antlr.ATNConfigSet) {
+}
+func (l *ErrorListener) ReportAttemptingFullContext(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, conflictingAlts *antlr.BitSet, configs antlr.ATNConfigSet) {
+}
+func (l *ErrorListener) ReportContextSensitivity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex, prediction int, configs antlr.ATNConfigSet) {
+}
@@ -0,0 +1,175 @@
+package parser
+
+import (
+	"fmt"
+	"go/token"
+	"path"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	// annotationPrefix starts the annotations of types and methods, e.g. //gointerface:ignore.
+	annotationPrefix = "//gointerface:"
+	// directivePrefix starts the annotations of the types gointerface generate processes.
+	directivePrefix = annotationPrefix + "generate"
+)
+
+// Directive is a //gointerface:generate annotation of a type declaration, or a
+// //go:generate gointerface line in its doc comment. Args are the flags following
+// the command, e.g. -o foo_iface.go.
+type Directive struct {
+	Args []string
+	Line int
+}
+
+// parseDirective parses comment if it is a directive. Malformed directives are returned
+// as an error, comments that are not directives as nil.
+func parseDirective(comment string, line int) (*Directive, error) {
+	var rest string
+	switch {
+	case strings.HasPrefix(comment, directivePrefix):
+		rest = comment[len(directivePrefix):]
+	case strings.HasPrefix(comment, "//go:generate "):
+		rest = comment[len("//go:generate "):]
+		fields := strings.Fields(rest)
+		if len(fields) == 0 || path.Base(fields[0]) != "gointerface" {
+			return nil, nil // another generator
+		}
+		rest = strings.TrimSpace(rest)[len(fields[0]):]
+	default:
+		return nil, nil
+	}
+	if rest != "" && !unicode.IsSpace(rune(rest[0])) {
+		return nil, nil // e.g. //gointerface:generated
+	}
+	args, err := splitArgs(rest)
+	if err != nil {
+		return nil, fmt.Errorf("invalid directive: %w", err)
+	}
+	return &Directive{Args: args, Line: line}, nil
+}
+
+// splitArgs splits s into space separated arguments, double quoted arguments are
+// unquoted like the go:generate ones, e.g. -name "{{.Type}}er".
+func splitArgs(s string) ([]string, error) {
+	var args []string
+	for {
+		s = strings.TrimLeftFunc(s, unicode.IsSpace)
+		if s == "" {
+			return args, nil
+		}
+		if s[0] != '"' {
+			end := strings.IndexFunc(s, unicode.IsSpace)
+			if end < 0 {
+				end = len(s)
+			}
+			args = append(args, s[:end])
+			s = s[end:]
+			continue
+		}
+		end := 1
+		for ; end < len(s) && s[end] != '"'; end++ {
+			if s[end] == '\\' {
+				end++
+			}
+		}
+		if end >= len(s) {
+			return nil, fmt.Errorf("unterminated quoted string %s", s)
+		}
+		arg, err := strconv.Unquote(s[:end+1])
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+		s = s[end+1:]
+	}
+}
+
+// annotation splits an annotation comment, e.g. //gointerface:name=Store, into its name
+// and value.
+func annotation(comment string) (name, value string, ok bool) {
+	if !strings.HasPrefix(comment, annotationPrefix) {
+		return "", "", false
+	}
+	name = comment[len(annotationPrefix):]
+	if i := strings.IndexFunc(name, unicode.IsSpace); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.IndexByte(name, '='); i >= 0 {
+		name, value = name[:i], name[i+1:]
+	}
+	return name, value, true
+}
+
+// annotateType records comment on decl if it is an annotation of a type declaration:
+// //gointerface:generate, //gointerface:name=Name or //gointerface:exclude. Malformed and
+// unknown annotations are reported as warnings of fileInfo.
+func annotateType(fileInfo *SourceFileInfo, decl *TypeDecl, comment string, line, column int) {
+	warn := func(format string, args ...interface{}) {
+		fileInfo.Diagnostics = append(fileInfo.Diagnostics, &Diagnostic{
+			File: fileInfo.FileName, Line: line, Column: column, Message: fmt.Sprintf(format, args...), Severity: SeverityWarning})
+	}
+
+	name, value, ok := annotation(comment)
+	if !ok || name == "generate" {
+		d, err := parseDirective(comment, line)
+		if err != nil {
+			warn("%v", err)
+			return
+		}
+		if d != nil {
+			decl.Directives = append(decl.Directives, d)
+		}
+		return
+	}
+	switch name {
+	case "name":
+		if !token.IsIdentifier(value) {
+			warn("invalid interface name %q of %s", value, decl.Identifier)
+			return
+		}
+		decl.Name = value
+	case "exclude":
+		decl.Exclude = true
+	default:
+		warn("unknown type annotation %s%s", annotationPrefix, name)
+	}
+}
+
+// annotateMethod applies the annotations of the comment of m, //gointerface:ignore and
+// //gointerface:group=Name, and removes them from the comment. It reports whether m is
+// kept, unknown annotations are reported as warnings of fileInfo.
+func annotateMethod(fileInfo *SourceFileInfo, m *MethodDecl) bool {
+	lines := strings.Split(m.Comment, "\n")
+	kept := lines[:0]
+	keep := true
+	for i, l := range lines {
+		name, value, ok := annotation(strings.TrimSpace(l))
+		if !ok {
+			kept = append(kept, l)
+			continue
+		}
+		// the comment ends on the line of the method
+		warn := func(format string, args ...interface{}) {
+			fileInfo.Diagnostics = append(fileInfo.Diagnostics, &Diagnostic{
+				File: fileInfo.FileName, Line: m.Line - (len(lines) - 1 - i), Column: strings.Index(l, "//") + 1,
+				Message: fmt.Sprintf(format, args...), Severity: SeverityWarning})
+		}
+		switch name {
+		case "ignore":
+			keep = false
+		case "group":
+			if !token.IsIdentifier(value) {
+				warn("invalid group %q of %s", value, m.Identifier)
+				continue
+			}
+			m.Group = value
+		default:
+			warn("unknown method annotation %s%s", annotationPrefix, name)
+		}
+	}
+	m.Comment = strings.Join(kept, "\n")
+	return keep
+}
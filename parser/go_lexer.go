@@ -51,7 +51,7 @@ func golexerLexerInit() {
 		"", "'('", "')'", "'{'", "'}'", "'['", "']'", "'='", "','", "';'", "':'",
 		"'.'", "'++'", "'--'", "':='", "'...'", "'||'", "'&&'", "'=='", "'!='",
 		"'<'", "'<='", "'>'", "'>='", "'|'", "'/'", "'%'", "'<<'", "'>>'", "'&^'",
-		"'!'", "'+'", "'-'", "'^'", "'*'", "'&'", "'<-'",
+		"'!'", "'+'", "'-'", "'^'", "'*'", "'&'", "'<-'", "'~'",
 	}
 	staticData.symbolicNames = []string{
 		"", "BREAK", "DEFAULT", "FUNC", "INTERFACE", "SELECT", "CASE", "DEFER",
@@ -63,11 +63,12 @@ func golexerLexerInit() {
 		"LOGICAL_AND", "EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS", "GREATER",
 		"GREATER_OR_EQUALS", "OR", "DIV", "MOD", "LSHIFT", "RSHIFT", "BIT_CLEAR",
 		"EXCLAMATION", "PLUS", "MINUS", "CARET", "STAR", "AMPERSAND", "RECEIVE",
-		"DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "DECIMAL_FLOAT_LIT",
-		"HEX_FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "BYTE_VALUE", "OCTAL_BYTE_VALUE",
-		"HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE", "RAW_STRING_LIT",
-		"INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
-		"WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI", "EOS", "OTHER",
+		"UNDERLYING", "DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT",
+		"DECIMAL_FLOAT_LIT", "HEX_FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "BYTE_VALUE",
+		"OCTAL_BYTE_VALUE", "HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE",
+		"RAW_STRING_LIT", "INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR",
+		"LINE_COMMENT", "WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI",
+		"EOS", "OTHER",
 	}
 	staticData.ruleNames = []string{
 		"BREAK", "DEFAULT", "FUNC", "INTERFACE", "SELECT", "CASE", "DEFER",
@@ -79,18 +80,18 @@ func golexerLexerInit() {
 		"LOGICAL_AND", "EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS", "GREATER",
 		"GREATER_OR_EQUALS", "OR", "DIV", "MOD", "LSHIFT", "RSHIFT", "BIT_CLEAR",
 		"EXCLAMATION", "PLUS", "MINUS", "CARET", "STAR", "AMPERSAND", "RECEIVE",
-		"DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "DECIMAL_FLOAT_LIT",
-		"HEX_FLOAT_LIT", "HEX_MANTISSA", "HEX_EXPONENT", "IMAGINARY_LIT", "RUNE",
-		"RUNE_LIT", "BYTE_VALUE", "OCTAL_BYTE_VALUE", "HEX_BYTE_VALUE", "LITTLE_U_VALUE",
-		"BIG_U_VALUE", "RAW_STRING_LIT", "INTERPRETED_STRING_LIT", "WS", "COMMENT",
-		"TERMINATOR", "LINE_COMMENT", "UNICODE_VALUE", "ESCAPED_VALUE", "DECIMALS",
-		"OCTAL_DIGIT", "HEX_DIGIT", "BIN_DIGIT", "EXPONENT", "LETTER", "UNICODE_DIGIT",
-		"UNICODE_LETTER", "WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI",
-		"EOS", "OTHER",
+		"UNDERLYING", "DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT",
+		"DECIMAL_FLOAT_LIT", "HEX_FLOAT_LIT", "HEX_MANTISSA", "HEX_EXPONENT",
+		"IMAGINARY_LIT", "RUNE", "RUNE_LIT", "BYTE_VALUE", "OCTAL_BYTE_VALUE",
+		"HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE", "RAW_STRING_LIT",
+		"INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
+		"UNICODE_VALUE", "ESCAPED_VALUE", "DECIMALS", "OCTAL_DIGIT", "HEX_DIGIT",
+		"BIN_DIGIT", "EXPONENT", "LETTER", "UNICODE_DIGIT", "UNICODE_LETTER",
+		"WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI", "EOS", "OTHER",
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 0, 88, 839, 6, -1, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3,
+		4, 0, 89, 843, 6, -1, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3,
 		7, 3, 2, 4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9,
 		7, 9, 2, 10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7,
 		14, 2, 15, 7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19,
@@ -109,490 +110,491 @@ func golexerLexerInit() {
 		2, 83, 7, 83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2,
 		88, 7, 88, 2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93,
 		7, 93, 2, 94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7,
-		98, 2, 99, 7, 99, 2, 100, 7, 100, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1,
-		0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1,
-		2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
-		3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 1, 5, 1,
-		5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1,
-		8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10,
-		1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1,
-		12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14,
-		1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1,
-		15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16,
-		1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 18, 1, 18, 1,
-		18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 20, 1, 20,
-		1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 21, 1,
-		21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1, 23,
-		1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1,
-		24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26,
-		5, 26, 376, 8, 26, 10, 26, 12, 26, 379, 9, 26, 1, 26, 1, 26, 1, 27, 1,
-		27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30,
-		1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 34, 1, 34, 1,
-		35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38,
-		1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 1,
-		41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1, 44, 1, 44, 1, 44,
-		1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1,
-		49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1, 52, 1, 53, 1, 53,
-		1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 57, 1,
-		57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 62, 1, 62,
-		1, 62, 1, 63, 1, 63, 1, 63, 3, 63, 483, 8, 63, 1, 63, 5, 63, 486, 8, 63,
-		10, 63, 12, 63, 489, 9, 63, 3, 63, 491, 8, 63, 1, 63, 1, 63, 1, 64, 1,
-		64, 1, 64, 3, 64, 498, 8, 64, 1, 64, 4, 64, 501, 8, 64, 11, 64, 12, 64,
-		502, 1, 64, 1, 64, 1, 65, 1, 65, 3, 65, 509, 8, 65, 1, 65, 3, 65, 512,
-		8, 65, 1, 65, 4, 65, 515, 8, 65, 11, 65, 12, 65, 516, 1, 65, 1, 65, 1,
-		66, 1, 66, 1, 66, 3, 66, 524, 8, 66, 1, 66, 4, 66, 527, 8, 66, 11, 66,
-		12, 66, 528, 1, 66, 1, 66, 1, 67, 1, 67, 3, 67, 535, 8, 67, 1, 67, 1, 67,
-		1, 68, 1, 68, 1, 68, 3, 68, 542, 8, 68, 1, 68, 3, 68, 545, 8, 68, 1, 68,
-		3, 68, 548, 8, 68, 1, 68, 1, 68, 1, 68, 3, 68, 553, 8, 68, 3, 68, 555,
-		8, 68, 1, 69, 1, 69, 1, 69, 1, 69, 1, 69, 1, 70, 3, 70, 563, 8, 70, 1,
-		70, 4, 70, 566, 8, 70, 11, 70, 12, 70, 567, 1, 70, 1, 70, 3, 70, 572, 8,
-		70, 1, 70, 5, 70, 575, 8, 70, 10, 70, 12, 70, 578, 9, 70, 3, 70, 580, 8,
-		70, 1, 70, 1, 70, 1, 70, 3, 70, 585, 8, 70, 1, 70, 5, 70, 588, 8, 70, 10,
-		70, 12, 70, 591, 9, 70, 3, 70, 593, 8, 70, 1, 71, 1, 71, 3, 71, 597, 8,
-		71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 72, 1, 72, 1, 72, 3, 72, 606, 8, 72,
-		1, 72, 1, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 3, 73, 615, 8, 73, 1,
-		73, 1, 73, 1, 74, 1, 74, 1, 74, 1, 74, 1, 75, 1, 75, 3, 75, 625, 8, 75,
-		1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1,
-		78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 79,
-		1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 5, 80, 657,
-		8, 80, 10, 80, 12, 80, 660, 9, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 81, 1,
-		81, 1, 81, 5, 81, 669, 8, 81, 10, 81, 12, 81, 672, 9, 81, 1, 81, 1, 81,
-		1, 81, 1, 81, 1, 82, 4, 82, 679, 8, 82, 11, 82, 12, 82, 680, 1, 82, 1,
-		82, 1, 83, 1, 83, 1, 83, 1, 83, 5, 83, 689, 8, 83, 10, 83, 12, 83, 692,
-		9, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 4, 84, 700, 8, 84, 11,
-		84, 12, 84, 701, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 5, 85, 710,
-		8, 85, 10, 85, 12, 85, 713, 9, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1,
-		86, 3, 86, 721, 8, 86, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87,
-		1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1,
-		87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 1, 87, 3, 87, 749,
-		8, 87, 1, 88, 1, 88, 3, 88, 753, 8, 88, 1, 88, 5, 88, 756, 8, 88, 10, 88,
-		12, 88, 759, 9, 88, 1, 89, 1, 89, 1, 90, 1, 90, 1, 91, 1, 91, 1, 92, 1,
-		92, 3, 92, 769, 8, 92, 1, 92, 1, 92, 1, 93, 1, 93, 3, 93, 775, 8, 93, 1,
-		94, 1, 94, 1, 95, 1, 95, 1, 96, 4, 96, 782, 8, 96, 11, 96, 12, 96, 783,
-		1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 97, 5, 97, 792, 8, 97, 10, 97, 12,
-		97, 795, 9, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98,
-		1, 98, 5, 98, 806, 8, 98, 10, 98, 12, 98, 809, 9, 98, 1, 98, 1, 98, 1,
-		99, 4, 99, 814, 8, 99, 11, 99, 12, 99, 815, 1, 99, 1, 99, 1, 99, 1, 99,
-		1, 99, 5, 99, 823, 8, 99, 10, 99, 12, 99, 826, 9, 99, 1, 99, 1, 99, 1,
-		99, 3, 99, 831, 8, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100, 1, 100, 1,
-		100, 3, 690, 793, 824, 0, 101, 2, 1, 4, 2, 6, 3, 8, 4, 10, 5, 12, 6, 14,
-		7, 16, 8, 18, 9, 20, 10, 22, 11, 24, 12, 26, 13, 28, 14, 30, 15, 32, 16,
-		34, 17, 36, 18, 38, 19, 40, 20, 42, 21, 44, 22, 46, 23, 48, 24, 50, 25,
-		52, 26, 54, 27, 56, 28, 58, 29, 60, 30, 62, 31, 64, 32, 66, 33, 68, 34,
-		70, 35, 72, 36, 74, 37, 76, 38, 78, 39, 80, 40, 82, 41, 84, 42, 86, 43,
-		88, 44, 90, 45, 92, 46, 94, 47, 96, 48, 98, 49, 100, 50, 102, 51, 104,
-		52, 106, 53, 108, 54, 110, 55, 112, 56, 114, 57, 116, 58, 118, 59, 120,
-		60, 122, 61, 124, 62, 126, 63, 128, 64, 130, 65, 132, 66, 134, 67, 136,
-		68, 138, 69, 140, 70, 142, 0, 144, 0, 146, 71, 148, 0, 150, 72, 152, 73,
-		154, 74, 156, 75, 158, 76, 160, 77, 162, 78, 164, 79, 166, 80, 168, 81,
-		170, 82, 172, 83, 174, 0, 176, 0, 178, 0, 180, 0, 182, 0, 184, 0, 186,
-		0, 188, 0, 190, 0, 192, 0, 194, 84, 196, 85, 198, 86, 200, 87, 202, 88,
-		2, 0, 1, 19, 1, 0, 49, 57, 1, 0, 48, 57, 2, 0, 66, 66, 98, 98, 2, 0, 79,
-		79, 111, 111, 2, 0, 88, 88, 120, 120, 2, 0, 80, 80, 112, 112, 2, 0, 43,
-		43, 45, 45, 1, 0, 96, 96, 2, 0, 34, 34, 92, 92, 2, 0, 9, 9, 32, 32, 2,
-		0, 10, 10, 13, 13, 3, 0, 10, 10, 13, 13, 39, 39, 9, 0, 34, 34, 39, 39,
-		92, 92, 97, 98, 102, 102, 110, 110, 114, 114, 116, 116, 118, 118, 1, 0,
-		48, 55, 3, 0, 48, 57, 65, 70, 97, 102, 1, 0, 48, 49, 2, 0, 69, 69, 101,
-		101, 61, 0, 48, 57, 1632, 1641, 1776, 1785, 1984, 1993, 2406, 2415, 2534,
-		2543, 2662, 2671, 2790, 2799, 2918, 2927, 3046, 3055, 3174, 3183, 3302,
-		3311, 3430, 3439, 3558, 3567, 3664, 3673, 3792, 3801, 3872, 3881, 4160,
-		4169, 4240, 4249, 6112, 6121, 6160, 6169, 6470, 6479, 6608, 6617, 6784,
-		6793, 6800, 6809, 6992, 7001, 7088, 7097, 7232, 7241, 7248, 7257, 42528,
-		42537, 43216, 43225, 43264, 43273, 43472, 43481, 43504, 43513, 43600, 43609,
-		44016, 44025, 65296, 65305, 66720, 66729, 68912, 68921, 69734, 69743, 69872,
-		69881, 69942, 69951, 70096, 70105, 70384, 70393, 70736, 70745, 70864, 70873,
-		71248, 71257, 71360, 71369, 71472, 71481, 71904, 71913, 72016, 72025, 72784,
-		72793, 73040, 73049, 73120, 73129, 92768, 92777, 93008, 93017, 120782,
-		120831, 123200, 123209, 123632, 123641, 125264, 125273, 130032, 130041,
-		622, 0, 65, 90, 97, 122, 170, 170, 181, 181, 186, 186, 192, 214, 216, 246,
-		248, 705, 710, 721, 736, 740, 748, 748, 750, 750, 880, 884, 886, 887, 890,
-		893, 895, 895, 902, 902, 904, 906, 908, 908, 910, 929, 931, 1013, 1015,
-		1153, 1162, 1327, 1329, 1366, 1369, 1369, 1376, 1416, 1488, 1514, 1519,
-		1522, 1568, 1610, 1646, 1647, 1649, 1747, 1749, 1749, 1765, 1766, 1774,
-		1775, 1786, 1788, 1791, 1791, 1808, 1808, 1810, 1839, 1869, 1957, 1969,
-		1969, 1994, 2026, 2036, 2037, 2042, 2042, 2048, 2069, 2074, 2074, 2084,
-		2084, 2088, 2088, 2112, 2136, 2144, 2154, 2208, 2228, 2230, 2247, 2308,
-		2361, 2365, 2365, 2384, 2384, 2392, 2401, 2417, 2432, 2437, 2444, 2447,
-		2448, 2451, 2472, 2474, 2480, 2482, 2482, 2486, 2489, 2493, 2493, 2510,
-		2510, 2524, 2525, 2527, 2529, 2544, 2545, 2556, 2556, 2565, 2570, 2575,
-		2576, 2579, 2600, 2602, 2608, 2610, 2611, 2613, 2614, 2616, 2617, 2649,
-		2652, 2654, 2654, 2674, 2676, 2693, 2701, 2703, 2705, 2707, 2728, 2730,
-		2736, 2738, 2739, 2741, 2745, 2749, 2749, 2768, 2768, 2784, 2785, 2809,
-		2809, 2821, 2828, 2831, 2832, 2835, 2856, 2858, 2864, 2866, 2867, 2869,
-		2873, 2877, 2877, 2908, 2909, 2911, 2913, 2929, 2929, 2947, 2947, 2949,
-		2954, 2958, 2960, 2962, 2965, 2969, 2970, 2972, 2972, 2974, 2975, 2979,
-		2980, 2984, 2986, 2990, 3001, 3024, 3024, 3077, 3084, 3086, 3088, 3090,
-		3112, 3114, 3129, 3133, 3133, 3160, 3162, 3168, 3169, 3200, 3200, 3205,
-		3212, 3214, 3216, 3218, 3240, 3242, 3251, 3253, 3257, 3261, 3261, 3294,
-		3294, 3296, 3297, 3313, 3314, 3332, 3340, 3342, 3344, 3346, 3386, 3389,
-		3389, 3406, 3406, 3412, 3414, 3423, 3425, 3450, 3455, 3461, 3478, 3482,
-		3505, 3507, 3515, 3517, 3517, 3520, 3526, 3585, 3632, 3634, 3635, 3648,
-		3654, 3713, 3714, 3716, 3716, 3718, 3722, 3724, 3747, 3749, 3749, 3751,
-		3760, 3762, 3763, 3773, 3773, 3776, 3780, 3782, 3782, 3804, 3807, 3840,
-		3840, 3904, 3911, 3913, 3948, 3976, 3980, 4096, 4138, 4159, 4159, 4176,
-		4181, 4186, 4189, 4193, 4193, 4197, 4198, 4206, 4208, 4213, 4225, 4238,
-		4238, 4256, 4293, 4295, 4295, 4301, 4301, 4304, 4346, 4348, 4680, 4682,
-		4685, 4688, 4694, 4696, 4696, 4698, 4701, 4704, 4744, 4746, 4749, 4752,
-		4784, 4786, 4789, 4792, 4798, 4800, 4800, 4802, 4805, 4808, 4822, 4824,
-		4880, 4882, 4885, 4888, 4954, 4992, 5007, 5024, 5109, 5112, 5117, 5121,
-		5740, 5743, 5759, 5761, 5786, 5792, 5866, 5873, 5880, 5888, 5900, 5902,
-		5905, 5920, 5937, 5952, 5969, 5984, 5996, 5998, 6000, 6016, 6067, 6103,
-		6103, 6108, 6108, 6176, 6264, 6272, 6276, 6279, 6312, 6314, 6314, 6320,
-		6389, 6400, 6430, 6480, 6509, 6512, 6516, 6528, 6571, 6576, 6601, 6656,
-		6678, 6688, 6740, 6823, 6823, 6917, 6963, 6981, 6987, 7043, 7072, 7086,
-		7087, 7098, 7141, 7168, 7203, 7245, 7247, 7258, 7293, 7296, 7304, 7312,
-		7354, 7357, 7359, 7401, 7404, 7406, 7411, 7413, 7414, 7418, 7418, 7424,
-		7615, 7680, 7957, 7960, 7965, 7968, 8005, 8008, 8013, 8016, 8023, 8025,
-		8025, 8027, 8027, 8029, 8029, 8031, 8061, 8064, 8116, 8118, 8124, 8126,
-		8126, 8130, 8132, 8134, 8140, 8144, 8147, 8150, 8155, 8160, 8172, 8178,
-		8180, 8182, 8188, 8305, 8305, 8319, 8319, 8336, 8348, 8450, 8450, 8455,
-		8455, 8458, 8467, 8469, 8469, 8473, 8477, 8484, 8484, 8486, 8486, 8488,
-		8488, 8490, 8493, 8495, 8505, 8508, 8511, 8517, 8521, 8526, 8526, 8579,
-		8580, 11264, 11310, 11312, 11358, 11360, 11492, 11499, 11502, 11506, 11507,
-		11520, 11557, 11559, 11559, 11565, 11565, 11568, 11623, 11631, 11631, 11648,
-		11670, 11680, 11686, 11688, 11694, 11696, 11702, 11704, 11710, 11712, 11718,
-		11720, 11726, 11728, 11734, 11736, 11742, 11823, 11823, 12293, 12294, 12337,
-		12341, 12347, 12348, 12353, 12438, 12445, 12447, 12449, 12538, 12540, 12543,
-		12549, 12591, 12593, 12686, 12704, 12735, 12784, 12799, 13312, 19903, 19968,
-		40956, 40960, 42124, 42192, 42237, 42240, 42508, 42512, 42527, 42538, 42539,
-		42560, 42606, 42623, 42653, 42656, 42725, 42775, 42783, 42786, 42888, 42891,
-		42943, 42946, 42954, 42997, 43009, 43011, 43013, 43015, 43018, 43020, 43042,
-		43072, 43123, 43138, 43187, 43250, 43255, 43259, 43259, 43261, 43262, 43274,
-		43301, 43312, 43334, 43360, 43388, 43396, 43442, 43471, 43471, 43488, 43492,
-		43494, 43503, 43514, 43518, 43520, 43560, 43584, 43586, 43588, 43595, 43616,
-		43638, 43642, 43642, 43646, 43695, 43697, 43697, 43701, 43702, 43705, 43709,
-		43712, 43712, 43714, 43714, 43739, 43741, 43744, 43754, 43762, 43764, 43777,
-		43782, 43785, 43790, 43793, 43798, 43808, 43814, 43816, 43822, 43824, 43866,
-		43868, 43881, 43888, 44002, 44032, 55203, 55216, 55238, 55243, 55291, 63744,
-		64109, 64112, 64217, 64256, 64262, 64275, 64279, 64285, 64285, 64287, 64296,
-		64298, 64310, 64312, 64316, 64318, 64318, 64320, 64321, 64323, 64324, 64326,
-		64433, 64467, 64829, 64848, 64911, 64914, 64967, 65008, 65019, 65136, 65140,
-		65142, 65276, 65313, 65338, 65345, 65370, 65382, 65470, 65474, 65479, 65482,
-		65487, 65490, 65495, 65498, 65500, 65536, 65547, 65549, 65574, 65576, 65594,
-		65596, 65597, 65599, 65613, 65616, 65629, 65664, 65786, 66176, 66204, 66208,
-		66256, 66304, 66335, 66349, 66368, 66370, 66377, 66384, 66421, 66432, 66461,
-		66464, 66499, 66504, 66511, 66560, 66717, 66736, 66771, 66776, 66811, 66816,
-		66855, 66864, 66915, 67072, 67382, 67392, 67413, 67424, 67431, 67584, 67589,
-		67592, 67592, 67594, 67637, 67639, 67640, 67644, 67644, 67647, 67669, 67680,
-		67702, 67712, 67742, 67808, 67826, 67828, 67829, 67840, 67861, 67872, 67897,
-		67968, 68023, 68030, 68031, 68096, 68096, 68112, 68115, 68117, 68119, 68121,
-		68149, 68192, 68220, 68224, 68252, 68288, 68295, 68297, 68324, 68352, 68405,
-		68416, 68437, 68448, 68466, 68480, 68497, 68608, 68680, 68736, 68786, 68800,
-		68850, 68864, 68899, 69248, 69289, 69296, 69297, 69376, 69404, 69415, 69415,
-		69424, 69445, 69552, 69572, 69600, 69622, 69635, 69687, 69763, 69807, 69840,
-		69864, 69891, 69926, 69956, 69956, 69959, 69959, 69968, 70002, 70006, 70006,
-		70019, 70066, 70081, 70084, 70106, 70106, 70108, 70108, 70144, 70161, 70163,
-		70187, 70272, 70278, 70280, 70280, 70282, 70285, 70287, 70301, 70303, 70312,
-		70320, 70366, 70405, 70412, 70415, 70416, 70419, 70440, 70442, 70448, 70450,
-		70451, 70453, 70457, 70461, 70461, 70480, 70480, 70493, 70497, 70656, 70708,
-		70727, 70730, 70751, 70753, 70784, 70831, 70852, 70853, 70855, 70855, 71040,
-		71086, 71128, 71131, 71168, 71215, 71236, 71236, 71296, 71338, 71352, 71352,
-		71424, 71450, 71680, 71723, 71840, 71903, 71935, 71942, 71945, 71945, 71948,
-		71955, 71957, 71958, 71960, 71983, 71999, 71999, 72001, 72001, 72096, 72103,
-		72106, 72144, 72161, 72161, 72163, 72163, 72192, 72192, 72203, 72242, 72250,
-		72250, 72272, 72272, 72284, 72329, 72349, 72349, 72384, 72440, 72704, 72712,
-		72714, 72750, 72768, 72768, 72818, 72847, 72960, 72966, 72968, 72969, 72971,
-		73008, 73030, 73030, 73056, 73061, 73063, 73064, 73066, 73097, 73112, 73112,
-		73440, 73458, 73648, 73648, 73728, 74649, 74880, 75075, 77824, 78894, 82944,
-		83526, 92160, 92728, 92736, 92766, 92880, 92909, 92928, 92975, 92992, 92995,
-		93027, 93047, 93053, 93071, 93760, 93823, 93952, 94026, 94032, 94032, 94099,
-		94111, 94176, 94177, 94179, 94179, 94208, 100343, 100352, 101589, 101632,
-		101640, 110592, 110878, 110928, 110930, 110948, 110951, 110960, 111355,
-		113664, 113770, 113776, 113788, 113792, 113800, 113808, 113817, 119808,
-		119892, 119894, 119964, 119966, 119967, 119970, 119970, 119973, 119974,
-		119977, 119980, 119982, 119993, 119995, 119995, 119997, 120003, 120005,
-		120069, 120071, 120074, 120077, 120084, 120086, 120092, 120094, 120121,
-		120123, 120126, 120128, 120132, 120134, 120134, 120138, 120144, 120146,
-		120485, 120488, 120512, 120514, 120538, 120540, 120570, 120572, 120596,
-		120598, 120628, 120630, 120654, 120656, 120686, 120688, 120712, 120714,
-		120744, 120746, 120770, 120772, 120779, 123136, 123180, 123191, 123197,
-		123214, 123214, 123584, 123627, 124928, 125124, 125184, 125251, 125259,
-		125259, 126464, 126467, 126469, 126495, 126497, 126498, 126500, 126500,
-		126503, 126503, 126505, 126514, 126516, 126519, 126521, 126521, 126523,
-		126523, 126530, 126530, 126535, 126535, 126537, 126537, 126539, 126539,
-		126541, 126543, 126545, 126546, 126548, 126548, 126551, 126551, 126553,
-		126553, 126555, 126555, 126557, 126557, 126559, 126559, 126561, 126562,
-		126564, 126564, 126567, 126570, 126572, 126578, 126580, 126583, 126585,
-		126588, 126590, 126590, 126592, 126601, 126603, 126619, 126625, 126627,
-		126629, 126633, 126635, 126651, 131072, 173789, 173824, 177972, 177984,
-		178205, 178208, 183969, 183984, 191456, 194560, 195101, 196608, 201546,
-		883, 0, 2, 1, 0, 0, 0, 0, 4, 1, 0, 0, 0, 0, 6, 1, 0, 0, 0, 0, 8, 1, 0,
-		0, 0, 0, 10, 1, 0, 0, 0, 0, 12, 1, 0, 0, 0, 0, 14, 1, 0, 0, 0, 0, 16, 1,
-		0, 0, 0, 0, 18, 1, 0, 0, 0, 0, 20, 1, 0, 0, 0, 0, 22, 1, 0, 0, 0, 0, 24,
-		1, 0, 0, 0, 0, 26, 1, 0, 0, 0, 0, 28, 1, 0, 0, 0, 0, 30, 1, 0, 0, 0, 0,
-		32, 1, 0, 0, 0, 0, 34, 1, 0, 0, 0, 0, 36, 1, 0, 0, 0, 0, 38, 1, 0, 0, 0,
-		0, 40, 1, 0, 0, 0, 0, 42, 1, 0, 0, 0, 0, 44, 1, 0, 0, 0, 0, 46, 1, 0, 0,
-		0, 0, 48, 1, 0, 0, 0, 0, 50, 1, 0, 0, 0, 0, 52, 1, 0, 0, 0, 0, 54, 1, 0,
-		0, 0, 0, 56, 1, 0, 0, 0, 0, 58, 1, 0, 0, 0, 0, 60, 1, 0, 0, 0, 0, 62, 1,
-		0, 0, 0, 0, 64, 1, 0, 0, 0, 0, 66, 1, 0, 0, 0, 0, 68, 1, 0, 0, 0, 0, 70,
-		1, 0, 0, 0, 0, 72, 1, 0, 0, 0, 0, 74, 1, 0, 0, 0, 0, 76, 1, 0, 0, 0, 0,
-		78, 1, 0, 0, 0, 0, 80, 1, 0, 0, 0, 0, 82, 1, 0, 0, 0, 0, 84, 1, 0, 0, 0,
-		0, 86, 1, 0, 0, 0, 0, 88, 1, 0, 0, 0, 0, 90, 1, 0, 0, 0, 0, 92, 1, 0, 0,
-		0, 0, 94, 1, 0, 0, 0, 0, 96, 1, 0, 0, 0, 0, 98, 1, 0, 0, 0, 0, 100, 1,
-		0, 0, 0, 0, 102, 1, 0, 0, 0, 0, 104, 1, 0, 0, 0, 0, 106, 1, 0, 0, 0, 0,
-		108, 1, 0, 0, 0, 0, 110, 1, 0, 0, 0, 0, 112, 1, 0, 0, 0, 0, 114, 1, 0,
-		0, 0, 0, 116, 1, 0, 0, 0, 0, 118, 1, 0, 0, 0, 0, 120, 1, 0, 0, 0, 0, 122,
-		1, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 126, 1, 0, 0, 0, 0, 128, 1, 0, 0, 0,
-		0, 130, 1, 0, 0, 0, 0, 132, 1, 0, 0, 0, 0, 134, 1, 0, 0, 0, 0, 136, 1,
-		0, 0, 0, 0, 138, 1, 0, 0, 0, 0, 140, 1, 0, 0, 0, 0, 146, 1, 0, 0, 0, 0,
-		150, 1, 0, 0, 0, 0, 152, 1, 0, 0, 0, 0, 154, 1, 0, 0, 0, 0, 156, 1, 0,
-		0, 0, 0, 158, 1, 0, 0, 0, 0, 160, 1, 0, 0, 0, 0, 162, 1, 0, 0, 0, 0, 164,
-		1, 0, 0, 0, 0, 166, 1, 0, 0, 0, 0, 168, 1, 0, 0, 0, 0, 170, 1, 0, 0, 0,
-		0, 172, 1, 0, 0, 0, 1, 194, 1, 0, 0, 0, 1, 196, 1, 0, 0, 0, 1, 198, 1,
-		0, 0, 0, 1, 200, 1, 0, 0, 0, 1, 202, 1, 0, 0, 0, 2, 204, 1, 0, 0, 0, 4,
-		212, 1, 0, 0, 0, 6, 220, 1, 0, 0, 0, 8, 225, 1, 0, 0, 0, 10, 235, 1, 0,
-		0, 0, 12, 242, 1, 0, 0, 0, 14, 247, 1, 0, 0, 0, 16, 253, 1, 0, 0, 0, 18,
-		256, 1, 0, 0, 0, 20, 260, 1, 0, 0, 0, 22, 267, 1, 0, 0, 0, 24, 272, 1,
-		0, 0, 0, 26, 277, 1, 0, 0, 0, 28, 282, 1, 0, 0, 0, 30, 290, 1, 0, 0, 0,
-		32, 297, 1, 0, 0, 0, 34, 303, 1, 0, 0, 0, 36, 317, 1, 0, 0, 0, 38, 320,
-		1, 0, 0, 0, 40, 326, 1, 0, 0, 0, 42, 331, 1, 0, 0, 0, 44, 342, 1, 0, 0,
-		0, 46, 346, 1, 0, 0, 0, 48, 353, 1, 0, 0, 0, 50, 362, 1, 0, 0, 0, 52, 366,
-		1, 0, 0, 0, 54, 372, 1, 0, 0, 0, 56, 382, 1, 0, 0, 0, 58, 384, 1, 0, 0,
-		0, 60, 388, 1, 0, 0, 0, 62, 390, 1, 0, 0, 0, 64, 394, 1, 0, 0, 0, 66, 396,
-		1, 0, 0, 0, 68, 400, 1, 0, 0, 0, 70, 402, 1, 0, 0, 0, 72, 404, 1, 0, 0,
-		0, 74, 406, 1, 0, 0, 0, 76, 408, 1, 0, 0, 0, 78, 410, 1, 0, 0, 0, 80, 415,
-		1, 0, 0, 0, 82, 420, 1, 0, 0, 0, 84, 423, 1, 0, 0, 0, 86, 427, 1, 0, 0,
-		0, 88, 430, 1, 0, 0, 0, 90, 433, 1, 0, 0, 0, 92, 436, 1, 0, 0, 0, 94, 439,
-		1, 0, 0, 0, 96, 441, 1, 0, 0, 0, 98, 444, 1, 0, 0, 0, 100, 446, 1, 0, 0,
-		0, 102, 449, 1, 0, 0, 0, 104, 451, 1, 0, 0, 0, 106, 453, 1, 0, 0, 0, 108,
-		455, 1, 0, 0, 0, 110, 458, 1, 0, 0, 0, 112, 461, 1, 0, 0, 0, 114, 464,
-		1, 0, 0, 0, 116, 466, 1, 0, 0, 0, 118, 468, 1, 0, 0, 0, 120, 470, 1, 0,
-		0, 0, 122, 472, 1, 0, 0, 0, 124, 474, 1, 0, 0, 0, 126, 476, 1, 0, 0, 0,
-		128, 490, 1, 0, 0, 0, 130, 494, 1, 0, 0, 0, 132, 506, 1, 0, 0, 0, 134,
-		520, 1, 0, 0, 0, 136, 534, 1, 0, 0, 0, 138, 554, 1, 0, 0, 0, 140, 556,
-		1, 0, 0, 0, 142, 592, 1, 0, 0, 0, 144, 594, 1, 0, 0, 0, 146, 605, 1, 0,
-		0, 0, 148, 611, 1, 0, 0, 0, 150, 618, 1, 0, 0, 0, 152, 624, 1, 0, 0, 0,
-		154, 626, 1, 0, 0, 0, 156, 631, 1, 0, 0, 0, 158, 636, 1, 0, 0, 0, 160,
-		643, 1, 0, 0, 0, 162, 654, 1, 0, 0, 0, 164, 665, 1, 0, 0, 0, 166, 678,
-		1, 0, 0, 0, 168, 684, 1, 0, 0, 0, 170, 699, 1, 0, 0, 0, 172, 705, 1, 0,
-		0, 0, 174, 720, 1, 0, 0, 0, 176, 722, 1, 0, 0, 0, 178, 750, 1, 0, 0, 0,
-		180, 760, 1, 0, 0, 0, 182, 762, 1, 0, 0, 0, 184, 764, 1, 0, 0, 0, 186,
-		766, 1, 0, 0, 0, 188, 774, 1, 0, 0, 0, 190, 776, 1, 0, 0, 0, 192, 778,
-		1, 0, 0, 0, 194, 781, 1, 0, 0, 0, 196, 787, 1, 0, 0, 0, 198, 801, 1, 0,
-		0, 0, 200, 830, 1, 0, 0, 0, 202, 834, 1, 0, 0, 0, 204, 205, 5, 98, 0, 0,
-		205, 206, 5, 114, 0, 0, 206, 207, 5, 101, 0, 0, 207, 208, 5, 97, 0, 0,
-		208, 209, 5, 107, 0, 0, 209, 210, 1, 0, 0, 0, 210, 211, 6, 0, 0, 0, 211,
-		3, 1, 0, 0, 0, 212, 213, 5, 100, 0, 0, 213, 214, 5, 101, 0, 0, 214, 215,
-		5, 102, 0, 0, 215, 216, 5, 97, 0, 0, 216, 217, 5, 117, 0, 0, 217, 218,
-		5, 108, 0, 0, 218, 219, 5, 116, 0, 0, 219, 5, 1, 0, 0, 0, 220, 221, 5,
-		102, 0, 0, 221, 222, 5, 117, 0, 0, 222, 223, 5, 110, 0, 0, 223, 224, 5,
-		99, 0, 0, 224, 7, 1, 0, 0, 0, 225, 226, 5, 105, 0, 0, 226, 227, 5, 110,
-		0, 0, 227, 228, 5, 116, 0, 0, 228, 229, 5, 101, 0, 0, 229, 230, 5, 114,
-		0, 0, 230, 231, 5, 102, 0, 0, 231, 232, 5, 97, 0, 0, 232, 233, 5, 99, 0,
-		0, 233, 234, 5, 101, 0, 0, 234, 9, 1, 0, 0, 0, 235, 236, 5, 115, 0, 0,
-		236, 237, 5, 101, 0, 0, 237, 238, 5, 108, 0, 0, 238, 239, 5, 101, 0, 0,
-		239, 240, 5, 99, 0, 0, 240, 241, 5, 116, 0, 0, 241, 11, 1, 0, 0, 0, 242,
-		243, 5, 99, 0, 0, 243, 244, 5, 97, 0, 0, 244, 245, 5, 115, 0, 0, 245, 246,
-		5, 101, 0, 0, 246, 13, 1, 0, 0, 0, 247, 248, 5, 100, 0, 0, 248, 249, 5,
-		101, 0, 0, 249, 250, 5, 102, 0, 0, 250, 251, 5, 101, 0, 0, 251, 252, 5,
-		114, 0, 0, 252, 15, 1, 0, 0, 0, 253, 254, 5, 103, 0, 0, 254, 255, 5, 111,
-		0, 0, 255, 17, 1, 0, 0, 0, 256, 257, 5, 109, 0, 0, 257, 258, 5, 97, 0,
-		0, 258, 259, 5, 112, 0, 0, 259, 19, 1, 0, 0, 0, 260, 261, 5, 115, 0, 0,
-		261, 262, 5, 116, 0, 0, 262, 263, 5, 114, 0, 0, 263, 264, 5, 117, 0, 0,
-		264, 265, 5, 99, 0, 0, 265, 266, 5, 116, 0, 0, 266, 21, 1, 0, 0, 0, 267,
-		268, 5, 99, 0, 0, 268, 269, 5, 104, 0, 0, 269, 270, 5, 97, 0, 0, 270, 271,
-		5, 110, 0, 0, 271, 23, 1, 0, 0, 0, 272, 273, 5, 101, 0, 0, 273, 274, 5,
-		108, 0, 0, 274, 275, 5, 115, 0, 0, 275, 276, 5, 101, 0, 0, 276, 25, 1,
-		0, 0, 0, 277, 278, 5, 103, 0, 0, 278, 279, 5, 111, 0, 0, 279, 280, 5, 116,
-		0, 0, 280, 281, 5, 111, 0, 0, 281, 27, 1, 0, 0, 0, 282, 283, 5, 112, 0,
-		0, 283, 284, 5, 97, 0, 0, 284, 285, 5, 99, 0, 0, 285, 286, 5, 107, 0, 0,
-		286, 287, 5, 97, 0, 0, 287, 288, 5, 103, 0, 0, 288, 289, 5, 101, 0, 0,
-		289, 29, 1, 0, 0, 0, 290, 291, 5, 115, 0, 0, 291, 292, 5, 119, 0, 0, 292,
-		293, 5, 105, 0, 0, 293, 294, 5, 116, 0, 0, 294, 295, 5, 99, 0, 0, 295,
-		296, 5, 104, 0, 0, 296, 31, 1, 0, 0, 0, 297, 298, 5, 99, 0, 0, 298, 299,
-		5, 111, 0, 0, 299, 300, 5, 110, 0, 0, 300, 301, 5, 115, 0, 0, 301, 302,
-		5, 116, 0, 0, 302, 33, 1, 0, 0, 0, 303, 304, 5, 102, 0, 0, 304, 305, 5,
-		97, 0, 0, 305, 306, 5, 108, 0, 0, 306, 307, 5, 108, 0, 0, 307, 308, 5,
-		116, 0, 0, 308, 309, 5, 104, 0, 0, 309, 310, 5, 114, 0, 0, 310, 311, 5,
-		111, 0, 0, 311, 312, 5, 117, 0, 0, 312, 313, 5, 103, 0, 0, 313, 314, 5,
-		104, 0, 0, 314, 315, 1, 0, 0, 0, 315, 316, 6, 16, 0, 0, 316, 35, 1, 0,
-		0, 0, 317, 318, 5, 105, 0, 0, 318, 319, 5, 102, 0, 0, 319, 37, 1, 0, 0,
-		0, 320, 321, 5, 114, 0, 0, 321, 322, 5, 97, 0, 0, 322, 323, 5, 110, 0,
-		0, 323, 324, 5, 103, 0, 0, 324, 325, 5, 101, 0, 0, 325, 39, 1, 0, 0, 0,
-		326, 327, 5, 116, 0, 0, 327, 328, 5, 121, 0, 0, 328, 329, 5, 112, 0, 0,
-		329, 330, 5, 101, 0, 0, 330, 41, 1, 0, 0, 0, 331, 332, 5, 99, 0, 0, 332,
-		333, 5, 111, 0, 0, 333, 334, 5, 110, 0, 0, 334, 335, 5, 116, 0, 0, 335,
-		336, 5, 105, 0, 0, 336, 337, 5, 110, 0, 0, 337, 338, 5, 117, 0, 0, 338,
-		339, 5, 101, 0, 0, 339, 340, 1, 0, 0, 0, 340, 341, 6, 20, 0, 0, 341, 43,
-		1, 0, 0, 0, 342, 343, 5, 102, 0, 0, 343, 344, 5, 111, 0, 0, 344, 345, 5,
-		114, 0, 0, 345, 45, 1, 0, 0, 0, 346, 347, 5, 105, 0, 0, 347, 348, 5, 109,
-		0, 0, 348, 349, 5, 112, 0, 0, 349, 350, 5, 111, 0, 0, 350, 351, 5, 114,
-		0, 0, 351, 352, 5, 116, 0, 0, 352, 47, 1, 0, 0, 0, 353, 354, 5, 114, 0,
-		0, 354, 355, 5, 101, 0, 0, 355, 356, 5, 116, 0, 0, 356, 357, 5, 117, 0,
-		0, 357, 358, 5, 114, 0, 0, 358, 359, 5, 110, 0, 0, 359, 360, 1, 0, 0, 0,
-		360, 361, 6, 23, 0, 0, 361, 49, 1, 0, 0, 0, 362, 363, 5, 118, 0, 0, 363,
-		364, 5, 97, 0, 0, 364, 365, 5, 114, 0, 0, 365, 51, 1, 0, 0, 0, 366, 367,
-		5, 110, 0, 0, 367, 368, 5, 105, 0, 0, 368, 369, 5, 108, 0, 0, 369, 370,
-		1, 0, 0, 0, 370, 371, 6, 25, 0, 0, 371, 53, 1, 0, 0, 0, 372, 377, 3, 188,
-		93, 0, 373, 376, 3, 188, 93, 0, 374, 376, 3, 190, 94, 0, 375, 373, 1, 0,
-		0, 0, 375, 374, 1, 0, 0, 0, 376, 379, 1, 0, 0, 0, 377, 375, 1, 0, 0, 0,
-		377, 378, 1, 0, 0, 0, 378, 380, 1, 0, 0, 0, 379, 377, 1, 0, 0, 0, 380,
-		381, 6, 26, 0, 0, 381, 55, 1, 0, 0, 0, 382, 383, 5, 40, 0, 0, 383, 57,
-		1, 0, 0, 0, 384, 385, 5, 41, 0, 0, 385, 386, 1, 0, 0, 0, 386, 387, 6, 28,
-		0, 0, 387, 59, 1, 0, 0, 0, 388, 389, 5, 123, 0, 0, 389, 61, 1, 0, 0, 0,
-		390, 391, 5, 125, 0, 0, 391, 392, 1, 0, 0, 0, 392, 393, 6, 30, 0, 0, 393,
-		63, 1, 0, 0, 0, 394, 395, 5, 91, 0, 0, 395, 65, 1, 0, 0, 0, 396, 397, 5,
-		93, 0, 0, 397, 398, 1, 0, 0, 0, 398, 399, 6, 32, 0, 0, 399, 67, 1, 0, 0,
-		0, 400, 401, 5, 61, 0, 0, 401, 69, 1, 0, 0, 0, 402, 403, 5, 44, 0, 0, 403,
-		71, 1, 0, 0, 0, 404, 405, 5, 59, 0, 0, 405, 73, 1, 0, 0, 0, 406, 407, 5,
-		58, 0, 0, 407, 75, 1, 0, 0, 0, 408, 409, 5, 46, 0, 0, 409, 77, 1, 0, 0,
-		0, 410, 411, 5, 43, 0, 0, 411, 412, 5, 43, 0, 0, 412, 413, 1, 0, 0, 0,
-		413, 414, 6, 38, 0, 0, 414, 79, 1, 0, 0, 0, 415, 416, 5, 45, 0, 0, 416,
-		417, 5, 45, 0, 0, 417, 418, 1, 0, 0, 0, 418, 419, 6, 39, 0, 0, 419, 81,
-		1, 0, 0, 0, 420, 421, 5, 58, 0, 0, 421, 422, 5, 61, 0, 0, 422, 83, 1, 0,
-		0, 0, 423, 424, 5, 46, 0, 0, 424, 425, 5, 46, 0, 0, 425, 426, 5, 46, 0,
-		0, 426, 85, 1, 0, 0, 0, 427, 428, 5, 124, 0, 0, 428, 429, 5, 124, 0, 0,
-		429, 87, 1, 0, 0, 0, 430, 431, 5, 38, 0, 0, 431, 432, 5, 38, 0, 0, 432,
-		89, 1, 0, 0, 0, 433, 434, 5, 61, 0, 0, 434, 435, 5, 61, 0, 0, 435, 91,
-		1, 0, 0, 0, 436, 437, 5, 33, 0, 0, 437, 438, 5, 61, 0, 0, 438, 93, 1, 0,
-		0, 0, 439, 440, 5, 60, 0, 0, 440, 95, 1, 0, 0, 0, 441, 442, 5, 60, 0, 0,
-		442, 443, 5, 61, 0, 0, 443, 97, 1, 0, 0, 0, 444, 445, 5, 62, 0, 0, 445,
-		99, 1, 0, 0, 0, 446, 447, 5, 62, 0, 0, 447, 448, 5, 61, 0, 0, 448, 101,
-		1, 0, 0, 0, 449, 450, 5, 124, 0, 0, 450, 103, 1, 0, 0, 0, 451, 452, 5,
-		47, 0, 0, 452, 105, 1, 0, 0, 0, 453, 454, 5, 37, 0, 0, 454, 107, 1, 0,
-		0, 0, 455, 456, 5, 60, 0, 0, 456, 457, 5, 60, 0, 0, 457, 109, 1, 0, 0,
-		0, 458, 459, 5, 62, 0, 0, 459, 460, 5, 62, 0, 0, 460, 111, 1, 0, 0, 0,
-		461, 462, 5, 38, 0, 0, 462, 463, 5, 94, 0, 0, 463, 113, 1, 0, 0, 0, 464,
-		465, 5, 33, 0, 0, 465, 115, 1, 0, 0, 0, 466, 467, 5, 43, 0, 0, 467, 117,
-		1, 0, 0, 0, 468, 469, 5, 45, 0, 0, 469, 119, 1, 0, 0, 0, 470, 471, 5, 94,
-		0, 0, 471, 121, 1, 0, 0, 0, 472, 473, 5, 42, 0, 0, 473, 123, 1, 0, 0, 0,
-		474, 475, 5, 38, 0, 0, 475, 125, 1, 0, 0, 0, 476, 477, 5, 60, 0, 0, 477,
-		478, 5, 45, 0, 0, 478, 127, 1, 0, 0, 0, 479, 491, 5, 48, 0, 0, 480, 487,
-		7, 0, 0, 0, 481, 483, 5, 95, 0, 0, 482, 481, 1, 0, 0, 0, 482, 483, 1, 0,
-		0, 0, 483, 484, 1, 0, 0, 0, 484, 486, 7, 1, 0, 0, 485, 482, 1, 0, 0, 0,
-		486, 489, 1, 0, 0, 0, 487, 485, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0, 488,
-		491, 1, 0, 0, 0, 489, 487, 1, 0, 0, 0, 490, 479, 1, 0, 0, 0, 490, 480,
-		1, 0, 0, 0, 491, 492, 1, 0, 0, 0, 492, 493, 6, 63, 0, 0, 493, 129, 1, 0,
-		0, 0, 494, 495, 5, 48, 0, 0, 495, 500, 7, 2, 0, 0, 496, 498, 5, 95, 0,
-		0, 497, 496, 1, 0, 0, 0, 497, 498, 1, 0, 0, 0, 498, 499, 1, 0, 0, 0, 499,
-		501, 3, 184, 91, 0, 500, 497, 1, 0, 0, 0, 501, 502, 1, 0, 0, 0, 502, 500,
-		1, 0, 0, 0, 502, 503, 1, 0, 0, 0, 503, 504, 1, 0, 0, 0, 504, 505, 6, 64,
-		0, 0, 505, 131, 1, 0, 0, 0, 506, 508, 5, 48, 0, 0, 507, 509, 7, 3, 0, 0,
-		508, 507, 1, 0, 0, 0, 508, 509, 1, 0, 0, 0, 509, 514, 1, 0, 0, 0, 510,
-		512, 5, 95, 0, 0, 511, 510, 1, 0, 0, 0, 511, 512, 1, 0, 0, 0, 512, 513,
-		1, 0, 0, 0, 513, 515, 3, 180, 89, 0, 514, 511, 1, 0, 0, 0, 515, 516, 1,
-		0, 0, 0, 516, 514, 1, 0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 518, 1, 0, 0,
-		0, 518, 519, 6, 65, 0, 0, 519, 133, 1, 0, 0, 0, 520, 521, 5, 48, 0, 0,
-		521, 526, 7, 4, 0, 0, 522, 524, 5, 95, 0, 0, 523, 522, 1, 0, 0, 0, 523,
-		524, 1, 0, 0, 0, 524, 525, 1, 0, 0, 0, 525, 527, 3, 182, 90, 0, 526, 523,
-		1, 0, 0, 0, 527, 528, 1, 0, 0, 0, 528, 526, 1, 0, 0, 0, 528, 529, 1, 0,
-		0, 0, 529, 530, 1, 0, 0, 0, 530, 531, 6, 66, 0, 0, 531, 135, 1, 0, 0, 0,
-		532, 535, 3, 138, 68, 0, 533, 535, 3, 140, 69, 0, 534, 532, 1, 0, 0, 0,
-		534, 533, 1, 0, 0, 0, 535, 536, 1, 0, 0, 0, 536, 537, 6, 67, 0, 0, 537,
-		137, 1, 0, 0, 0, 538, 547, 3, 178, 88, 0, 539, 541, 5, 46, 0, 0, 540, 542,
-		3, 178, 88, 0, 541, 540, 1, 0, 0, 0, 541, 542, 1, 0, 0, 0, 542, 544, 1,
-		0, 0, 0, 543, 545, 3, 186, 92, 0, 544, 543, 1, 0, 0, 0, 544, 545, 1, 0,
-		0, 0, 545, 548, 1, 0, 0, 0, 546, 548, 3, 186, 92, 0, 547, 539, 1, 0, 0,
-		0, 547, 546, 1, 0, 0, 0, 548, 555, 1, 0, 0, 0, 549, 550, 5, 46, 0, 0, 550,
-		552, 3, 178, 88, 0, 551, 553, 3, 186, 92, 0, 552, 551, 1, 0, 0, 0, 552,
-		553, 1, 0, 0, 0, 553, 555, 1, 0, 0, 0, 554, 538, 1, 0, 0, 0, 554, 549,
-		1, 0, 0, 0, 555, 139, 1, 0, 0, 0, 556, 557, 5, 48, 0, 0, 557, 558, 7, 4,
-		0, 0, 558, 559, 3, 142, 70, 0, 559, 560, 3, 144, 71, 0, 560, 141, 1, 0,
-		0, 0, 561, 563, 5, 95, 0, 0, 562, 561, 1, 0, 0, 0, 562, 563, 1, 0, 0, 0,
-		563, 564, 1, 0, 0, 0, 564, 566, 3, 182, 90, 0, 565, 562, 1, 0, 0, 0, 566,
-		567, 1, 0, 0, 0, 567, 565, 1, 0, 0, 0, 567, 568, 1, 0, 0, 0, 568, 579,
-		1, 0, 0, 0, 569, 576, 5, 46, 0, 0, 570, 572, 5, 95, 0, 0, 571, 570, 1,
-		0, 0, 0, 571, 572, 1, 0, 0, 0, 572, 573, 1, 0, 0, 0, 573, 575, 3, 182,
-		90, 0, 574, 571, 1, 0, 0, 0, 575, 578, 1, 0, 0, 0, 576, 574, 1, 0, 0, 0,
-		576, 577, 1, 0, 0, 0, 577, 580, 1, 0, 0, 0, 578, 576, 1, 0, 0, 0, 579,
-		569, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 593, 1, 0, 0, 0, 581, 582,
-		5, 46, 0, 0, 582, 589, 3, 182, 90, 0, 583, 585, 5, 95, 0, 0, 584, 583,
-		1, 0, 0, 0, 584, 585, 1, 0, 0, 0, 585, 586, 1, 0, 0, 0, 586, 588, 3, 182,
-		90, 0, 587, 584, 1, 0, 0, 0, 588, 591, 1, 0, 0, 0, 589, 587, 1, 0, 0, 0,
-		589, 590, 1, 0, 0, 0, 590, 593, 1, 0, 0, 0, 591, 589, 1, 0, 0, 0, 592,
-		565, 1, 0, 0, 0, 592, 581, 1, 0, 0, 0, 593, 143, 1, 0, 0, 0, 594, 596,
-		7, 5, 0, 0, 595, 597, 7, 6, 0, 0, 596, 595, 1, 0, 0, 0, 596, 597, 1, 0,
-		0, 0, 597, 598, 1, 0, 0, 0, 598, 599, 3, 178, 88, 0, 599, 145, 1, 0, 0,
-		0, 600, 606, 3, 128, 63, 0, 601, 606, 3, 130, 64, 0, 602, 606, 3, 132,
-		65, 0, 603, 606, 3, 134, 66, 0, 604, 606, 3, 136, 67, 0, 605, 600, 1, 0,
-		0, 0, 605, 601, 1, 0, 0, 0, 605, 602, 1, 0, 0, 0, 605, 603, 1, 0, 0, 0,
-		605, 604, 1, 0, 0, 0, 606, 607, 1, 0, 0, 0, 607, 608, 5, 105, 0, 0, 608,
-		609, 1, 0, 0, 0, 609, 610, 6, 72, 0, 0, 610, 147, 1, 0, 0, 0, 611, 614,
-		5, 39, 0, 0, 612, 615, 3, 174, 86, 0, 613, 615, 3, 152, 75, 0, 614, 612,
-		1, 0, 0, 0, 614, 613, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 617, 5, 39,
-		0, 0, 617, 149, 1, 0, 0, 0, 618, 619, 3, 148, 73, 0, 619, 620, 1, 0, 0,
-		0, 620, 621, 6, 74, 0, 0, 621, 151, 1, 0, 0, 0, 622, 625, 3, 154, 76, 0,
-		623, 625, 3, 156, 77, 0, 624, 622, 1, 0, 0, 0, 624, 623, 1, 0, 0, 0, 625,
-		153, 1, 0, 0, 0, 626, 627, 5, 92, 0, 0, 627, 628, 3, 180, 89, 0, 628, 629,
-		3, 180, 89, 0, 629, 630, 3, 180, 89, 0, 630, 155, 1, 0, 0, 0, 631, 632,
-		5, 92, 0, 0, 632, 633, 5, 120, 0, 0, 633, 634, 3, 182, 90, 0, 634, 635,
-		3, 182, 90, 0, 635, 157, 1, 0, 0, 0, 636, 637, 5, 92, 0, 0, 637, 638, 5,
-		117, 0, 0, 638, 639, 3, 182, 90, 0, 639, 640, 3, 182, 90, 0, 640, 641,
-		3, 182, 90, 0, 641, 642, 3, 182, 90, 0, 642, 159, 1, 0, 0, 0, 643, 644,
-		5, 92, 0, 0, 644, 645, 5, 85, 0, 0, 645, 646, 3, 182, 90, 0, 646, 647,
-		3, 182, 90, 0, 647, 648, 3, 182, 90, 0, 648, 649, 3, 182, 90, 0, 649, 650,
-		3, 182, 90, 0, 650, 651, 3, 182, 90, 0, 651, 652, 3, 182, 90, 0, 652, 653,
-		3, 182, 90, 0, 653, 161, 1, 0, 0, 0, 654, 658, 5, 96, 0, 0, 655, 657, 8,
-		7, 0, 0, 656, 655, 1, 0, 0, 0, 657, 660, 1, 0, 0, 0, 658, 656, 1, 0, 0,
-		0, 658, 659, 1, 0, 0, 0, 659, 661, 1, 0, 0, 0, 660, 658, 1, 0, 0, 0, 661,
-		662, 5, 96, 0, 0, 662, 663, 1, 0, 0, 0, 663, 664, 6, 80, 0, 0, 664, 163,
-		1, 0, 0, 0, 665, 670, 5, 34, 0, 0, 666, 669, 8, 8, 0, 0, 667, 669, 3, 176,
-		87, 0, 668, 666, 1, 0, 0, 0, 668, 667, 1, 0, 0, 0, 669, 672, 1, 0, 0, 0,
-		670, 668, 1, 0, 0, 0, 670, 671, 1, 0, 0, 0, 671, 673, 1, 0, 0, 0, 672,
-		670, 1, 0, 0, 0, 673, 674, 5, 34, 0, 0, 674, 675, 1, 0, 0, 0, 675, 676,
-		6, 81, 0, 0, 676, 165, 1, 0, 0, 0, 677, 679, 7, 9, 0, 0, 678, 677, 1, 0,
-		0, 0, 679, 680, 1, 0, 0, 0, 680, 678, 1, 0, 0, 0, 680, 681, 1, 0, 0, 0,
-		681, 682, 1, 0, 0, 0, 682, 683, 6, 82, 1, 0, 683, 167, 1, 0, 0, 0, 684,
-		685, 5, 47, 0, 0, 685, 686, 5, 42, 0, 0, 686, 690, 1, 0, 0, 0, 687, 689,
-		9, 0, 0, 0, 688, 687, 1, 0, 0, 0, 689, 692, 1, 0, 0, 0, 690, 691, 1, 0,
-		0, 0, 690, 688, 1, 0, 0, 0, 691, 693, 1, 0, 0, 0, 692, 690, 1, 0, 0, 0,
-		693, 694, 5, 42, 0, 0, 694, 695, 5, 47, 0, 0, 695, 696, 1, 0, 0, 0, 696,
-		697, 6, 83, 1, 0, 697, 169, 1, 0, 0, 0, 698, 700, 7, 10, 0, 0, 699, 698,
-		1, 0, 0, 0, 700, 701, 1, 0, 0, 0, 701, 699, 1, 0, 0, 0, 701, 702, 1, 0,
-		0, 0, 702, 703, 1, 0, 0, 0, 703, 704, 6, 84, 1, 0, 704, 171, 1, 0, 0, 0,
-		705, 706, 5, 47, 0, 0, 706, 707, 5, 47, 0, 0, 707, 711, 1, 0, 0, 0, 708,
-		710, 8, 10, 0, 0, 709, 708, 1, 0, 0, 0, 710, 713, 1, 0, 0, 0, 711, 709,
-		1, 0, 0, 0, 711, 712, 1, 0, 0, 0, 712, 714, 1, 0, 0, 0, 713, 711, 1, 0,
-		0, 0, 714, 715, 6, 85, 1, 0, 715, 173, 1, 0, 0, 0, 716, 721, 8, 11, 0,
-		0, 717, 721, 3, 158, 78, 0, 718, 721, 3, 160, 79, 0, 719, 721, 3, 176,
-		87, 0, 720, 716, 1, 0, 0, 0, 720, 717, 1, 0, 0, 0, 720, 718, 1, 0, 0, 0,
-		720, 719, 1, 0, 0, 0, 721, 175, 1, 0, 0, 0, 722, 748, 5, 92, 0, 0, 723,
-		724, 5, 117, 0, 0, 724, 725, 3, 182, 90, 0, 725, 726, 3, 182, 90, 0, 726,
-		727, 3, 182, 90, 0, 727, 728, 3, 182, 90, 0, 728, 749, 1, 0, 0, 0, 729,
-		730, 5, 85, 0, 0, 730, 731, 3, 182, 90, 0, 731, 732, 3, 182, 90, 0, 732,
-		733, 3, 182, 90, 0, 733, 734, 3, 182, 90, 0, 734, 735, 3, 182, 90, 0, 735,
-		736, 3, 182, 90, 0, 736, 737, 3, 182, 90, 0, 737, 738, 3, 182, 90, 0, 738,
-		749, 1, 0, 0, 0, 739, 749, 7, 12, 0, 0, 740, 741, 3, 180, 89, 0, 741, 742,
-		3, 180, 89, 0, 742, 743, 3, 180, 89, 0, 743, 749, 1, 0, 0, 0, 744, 745,
-		5, 120, 0, 0, 745, 746, 3, 182, 90, 0, 746, 747, 3, 182, 90, 0, 747, 749,
-		1, 0, 0, 0, 748, 723, 1, 0, 0, 0, 748, 729, 1, 0, 0, 0, 748, 739, 1, 0,
-		0, 0, 748, 740, 1, 0, 0, 0, 748, 744, 1, 0, 0, 0, 749, 177, 1, 0, 0, 0,
-		750, 757, 7, 1, 0, 0, 751, 753, 5, 95, 0, 0, 752, 751, 1, 0, 0, 0, 752,
-		753, 1, 0, 0, 0, 753, 754, 1, 0, 0, 0, 754, 756, 7, 1, 0, 0, 755, 752,
-		1, 0, 0, 0, 756, 759, 1, 0, 0, 0, 757, 755, 1, 0, 0, 0, 757, 758, 1, 0,
-		0, 0, 758, 179, 1, 0, 0, 0, 759, 757, 1, 0, 0, 0, 760, 761, 7, 13, 0, 0,
-		761, 181, 1, 0, 0, 0, 762, 763, 7, 14, 0, 0, 763, 183, 1, 0, 0, 0, 764,
-		765, 7, 15, 0, 0, 765, 185, 1, 0, 0, 0, 766, 768, 7, 16, 0, 0, 767, 769,
-		7, 6, 0, 0, 768, 767, 1, 0, 0, 0, 768, 769, 1, 0, 0, 0, 769, 770, 1, 0,
-		0, 0, 770, 771, 3, 178, 88, 0, 771, 187, 1, 0, 0, 0, 772, 775, 3, 192,
-		95, 0, 773, 775, 5, 95, 0, 0, 774, 772, 1, 0, 0, 0, 774, 773, 1, 0, 0,
-		0, 775, 189, 1, 0, 0, 0, 776, 777, 7, 17, 0, 0, 777, 191, 1, 0, 0, 0, 778,
-		779, 7, 18, 0, 0, 779, 193, 1, 0, 0, 0, 780, 782, 7, 9, 0, 0, 781, 780,
-		1, 0, 0, 0, 782, 783, 1, 0, 0, 0, 783, 781, 1, 0, 0, 0, 783, 784, 1, 0,
-		0, 0, 784, 785, 1, 0, 0, 0, 785, 786, 6, 96, 1, 0, 786, 195, 1, 0, 0, 0,
-		787, 788, 5, 47, 0, 0, 788, 789, 5, 42, 0, 0, 789, 793, 1, 0, 0, 0, 790,
-		792, 8, 10, 0, 0, 791, 790, 1, 0, 0, 0, 792, 795, 1, 0, 0, 0, 793, 794,
-		1, 0, 0, 0, 793, 791, 1, 0, 0, 0, 794, 796, 1, 0, 0, 0, 795, 793, 1, 0,
-		0, 0, 796, 797, 5, 42, 0, 0, 797, 798, 5, 47, 0, 0, 798, 799, 1, 0, 0,
-		0, 799, 800, 6, 97, 1, 0, 800, 197, 1, 0, 0, 0, 801, 802, 5, 47, 0, 0,
-		802, 803, 5, 47, 0, 0, 803, 807, 1, 0, 0, 0, 804, 806, 8, 10, 0, 0, 805,
-		804, 1, 0, 0, 0, 806, 809, 1, 0, 0, 0, 807, 805, 1, 0, 0, 0, 807, 808,
-		1, 0, 0, 0, 808, 810, 1, 0, 0, 0, 809, 807, 1, 0, 0, 0, 810, 811, 6, 98,
-		1, 0, 811, 199, 1, 0, 0, 0, 812, 814, 7, 10, 0, 0, 813, 812, 1, 0, 0, 0,
-		814, 815, 1, 0, 0, 0, 815, 813, 1, 0, 0, 0, 815, 816, 1, 0, 0, 0, 816,
-		831, 1, 0, 0, 0, 817, 831, 5, 59, 0, 0, 818, 819, 5, 47, 0, 0, 819, 820,
-		5, 42, 0, 0, 820, 824, 1, 0, 0, 0, 821, 823, 9, 0, 0, 0, 822, 821, 1, 0,
-		0, 0, 823, 826, 1, 0, 0, 0, 824, 825, 1, 0, 0, 0, 824, 822, 1, 0, 0, 0,
-		825, 827, 1, 0, 0, 0, 826, 824, 1, 0, 0, 0, 827, 828, 5, 42, 0, 0, 828,
-		831, 5, 47, 0, 0, 829, 831, 5, 0, 0, 1, 830, 813, 1, 0, 0, 0, 830, 817,
-		1, 0, 0, 0, 830, 818, 1, 0, 0, 0, 830, 829, 1, 0, 0, 0, 831, 832, 1, 0,
-		0, 0, 832, 833, 6, 99, 2, 0, 833, 201, 1, 0, 0, 0, 834, 835, 1, 0, 0, 0,
-		835, 836, 1, 0, 0, 0, 836, 837, 6, 100, 2, 0, 837, 838, 6, 100, 1, 0, 838,
-		203, 1, 0, 0, 0, 51, 0, 1, 375, 377, 482, 487, 490, 497, 502, 508, 511,
-		516, 523, 528, 534, 541, 544, 547, 552, 554, 562, 567, 571, 576, 579, 584,
-		589, 592, 596, 605, 614, 624, 658, 668, 670, 680, 690, 701, 711, 720, 748,
-		752, 757, 768, 774, 783, 793, 807, 815, 824, 830, 3, 2, 1, 0, 0, 1, 0,
-		2, 0, 0,
+		98, 2, 99, 7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 1, 0, 1, 0, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+		1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1,
+		3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 4, 1, 5, 1,
+		5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 7, 1, 7, 1,
+		7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12,
+		1, 12, 1, 12, 1, 12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 14, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17,
+		1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1,
+		19, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20, 1, 20,
+		1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22, 1, 22, 1,
+		22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23,
+		1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1,
+		26, 1, 26, 1, 26, 5, 26, 378, 8, 26, 10, 26, 12, 26, 381, 9, 26, 1, 26,
+		1, 26, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 30, 1,
+		30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33,
+		1, 34, 1, 34, 1, 35, 1, 35, 1, 36, 1, 36, 1, 37, 1, 37, 1, 38, 1, 38, 1,
+		38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 40,
+		1, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 1, 43, 1, 43, 1, 43, 1,
+		44, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 47,
+		1, 48, 1, 48, 1, 49, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1,
+		52, 1, 53, 1, 53, 1, 53, 1, 54, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56,
+		1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1,
+		61, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 3, 64, 487,
+		8, 64, 1, 64, 5, 64, 490, 8, 64, 10, 64, 12, 64, 493, 9, 64, 3, 64, 495,
+		8, 64, 1, 64, 1, 64, 1, 65, 1, 65, 1, 65, 3, 65, 502, 8, 65, 1, 65, 4,
+		65, 505, 8, 65, 11, 65, 12, 65, 506, 1, 65, 1, 65, 1, 66, 1, 66, 3, 66,
+		513, 8, 66, 1, 66, 3, 66, 516, 8, 66, 1, 66, 4, 66, 519, 8, 66, 11, 66,
+		12, 66, 520, 1, 66, 1, 66, 1, 67, 1, 67, 1, 67, 3, 67, 528, 8, 67, 1, 67,
+		4, 67, 531, 8, 67, 11, 67, 12, 67, 532, 1, 67, 1, 67, 1, 68, 1, 68, 3,
+		68, 539, 8, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 3, 69, 546, 8, 69, 1,
+		69, 3, 69, 549, 8, 69, 1, 69, 3, 69, 552, 8, 69, 1, 69, 1, 69, 1, 69, 3,
+		69, 557, 8, 69, 3, 69, 559, 8, 69, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1,
+		71, 3, 71, 567, 8, 71, 1, 71, 4, 71, 570, 8, 71, 11, 71, 12, 71, 571, 1,
+		71, 1, 71, 3, 71, 576, 8, 71, 1, 71, 5, 71, 579, 8, 71, 10, 71, 12, 71,
+		582, 9, 71, 3, 71, 584, 8, 71, 1, 71, 1, 71, 1, 71, 3, 71, 589, 8, 71,
+		1, 71, 5, 71, 592, 8, 71, 10, 71, 12, 71, 595, 9, 71, 3, 71, 597, 8, 71,
+		1, 72, 1, 72, 3, 72, 601, 8, 72, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1,
+		73, 1, 73, 3, 73, 610, 8, 73, 1, 73, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74,
+		1, 74, 3, 74, 619, 8, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1, 75, 1,
+		76, 1, 76, 3, 76, 629, 8, 76, 1, 77, 1, 77, 1, 77, 1, 77, 1, 77, 1, 78,
+		1, 78, 1, 78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1, 79, 1,
+		79, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80, 1, 80,
+		1, 80, 1, 81, 1, 81, 5, 81, 661, 8, 81, 10, 81, 12, 81, 664, 9, 81, 1,
+		81, 1, 81, 1, 81, 1, 81, 1, 82, 1, 82, 1, 82, 5, 82, 673, 8, 82, 10, 82,
+		12, 82, 676, 9, 82, 1, 82, 1, 82, 1, 82, 1, 82, 1, 83, 4, 83, 683, 8, 83,
+		11, 83, 12, 83, 684, 1, 83, 1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 5, 84, 693,
+		8, 84, 10, 84, 12, 84, 696, 9, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1, 84, 1,
+		85, 4, 85, 704, 8, 85, 11, 85, 12, 85, 705, 1, 85, 1, 85, 1, 86, 1, 86,
+		1, 86, 1, 86, 5, 86, 714, 8, 86, 10, 86, 12, 86, 717, 9, 86, 1, 86, 1,
+		86, 1, 87, 1, 87, 1, 87, 1, 87, 3, 87, 725, 8, 87, 1, 88, 1, 88, 1, 88,
+		1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1,
+		88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88, 1, 88,
+		1, 88, 1, 88, 3, 88, 753, 8, 88, 1, 89, 1, 89, 3, 89, 757, 8, 89, 1, 89,
+		5, 89, 760, 8, 89, 10, 89, 12, 89, 763, 9, 89, 1, 90, 1, 90, 1, 91, 1,
+		91, 1, 92, 1, 92, 1, 93, 1, 93, 3, 93, 773, 8, 93, 1, 93, 1, 93, 1, 94,
+		1, 94, 3, 94, 779, 8, 94, 1, 95, 1, 95, 1, 96, 1, 96, 1, 97, 4, 97, 786,
+		8, 97, 11, 97, 12, 97, 787, 1, 97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 5,
+		98, 796, 8, 98, 10, 98, 12, 98, 799, 9, 98, 1, 98, 1, 98, 1, 98, 1, 98,
+		1, 98, 1, 99, 1, 99, 1, 99, 1, 99, 5, 99, 810, 8, 99, 10, 99, 12, 99, 813,
+		9, 99, 1, 99, 1, 99, 1, 100, 4, 100, 818, 8, 100, 11, 100, 12, 100, 819,
+		1, 100, 1, 100, 1, 100, 1, 100, 1, 100, 5, 100, 827, 8, 100, 10, 100, 12,
+		100, 830, 9, 100, 1, 100, 1, 100, 1, 100, 3, 100, 835, 8, 100, 1, 100,
+		1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 101, 3, 694, 797, 828, 0, 102,
+		2, 1, 4, 2, 6, 3, 8, 4, 10, 5, 12, 6, 14, 7, 16, 8, 18, 9, 20, 10, 22,
+		11, 24, 12, 26, 13, 28, 14, 30, 15, 32, 16, 34, 17, 36, 18, 38, 19, 40,
+		20, 42, 21, 44, 22, 46, 23, 48, 24, 50, 25, 52, 26, 54, 27, 56, 28, 58,
+		29, 60, 30, 62, 31, 64, 32, 66, 33, 68, 34, 70, 35, 72, 36, 74, 37, 76,
+		38, 78, 39, 80, 40, 82, 41, 84, 42, 86, 43, 88, 44, 90, 45, 92, 46, 94,
+		47, 96, 48, 98, 49, 100, 50, 102, 51, 104, 52, 106, 53, 108, 54, 110, 55,
+		112, 56, 114, 57, 116, 58, 118, 59, 120, 60, 122, 61, 124, 62, 126, 63,
+		128, 64, 130, 65, 132, 66, 134, 67, 136, 68, 138, 69, 140, 70, 142, 71,
+		144, 0, 146, 0, 148, 72, 150, 0, 152, 73, 154, 74, 156, 75, 158, 76, 160,
+		77, 162, 78, 164, 79, 166, 80, 168, 81, 170, 82, 172, 83, 174, 84, 176,
+		0, 178, 0, 180, 0, 182, 0, 184, 0, 186, 0, 188, 0, 190, 0, 192, 0, 194,
+		0, 196, 85, 198, 86, 200, 87, 202, 88, 204, 89, 2, 0, 1, 19, 1, 0, 49,
+		57, 1, 0, 48, 57, 2, 0, 66, 66, 98, 98, 2, 0, 79, 79, 111, 111, 2, 0, 88,
+		88, 120, 120, 2, 0, 80, 80, 112, 112, 2, 0, 43, 43, 45, 45, 1, 0, 96, 96,
+		2, 0, 34, 34, 92, 92, 2, 0, 9, 9, 32, 32, 2, 0, 10, 10, 13, 13, 3, 0, 10,
+		10, 13, 13, 39, 39, 9, 0, 34, 34, 39, 39, 92, 92, 97, 98, 102, 102, 110,
+		110, 114, 114, 116, 116, 118, 118, 1, 0, 48, 55, 3, 0, 48, 57, 65, 70,
+		97, 102, 1, 0, 48, 49, 2, 0, 69, 69, 101, 101, 61, 0, 48, 57, 1632, 1641,
+		1776, 1785, 1984, 1993, 2406, 2415, 2534, 2543, 2662, 2671, 2790, 2799,
+		2918, 2927, 3046, 3055, 3174, 3183, 3302, 3311, 3430, 3439, 3558, 3567,
+		3664, 3673, 3792, 3801, 3872, 3881, 4160, 4169, 4240, 4249, 6112, 6121,
+		6160, 6169, 6470, 6479, 6608, 6617, 6784, 6793, 6800, 6809, 6992, 7001,
+		7088, 7097, 7232, 7241, 7248, 7257, 42528, 42537, 43216, 43225, 43264,
+		43273, 43472, 43481, 43504, 43513, 43600, 43609, 44016, 44025, 65296, 65305,
+		66720, 66729, 68912, 68921, 69734, 69743, 69872, 69881, 69942, 69951, 70096,
+		70105, 70384, 70393, 70736, 70745, 70864, 70873, 71248, 71257, 71360, 71369,
+		71472, 71481, 71904, 71913, 72016, 72025, 72784, 72793, 73040, 73049, 73120,
+		73129, 92768, 92777, 93008, 93017, 120782, 120831, 123200, 123209, 123632,
+		123641, 125264, 125273, 130032, 130041, 622, 0, 65, 90, 97, 122, 170, 170,
+		181, 181, 186, 186, 192, 214, 216, 246, 248, 705, 710, 721, 736, 740, 748,
+		748, 750, 750, 880, 884, 886, 887, 890, 893, 895, 895, 902, 902, 904, 906,
+		908, 908, 910, 929, 931, 1013, 1015, 1153, 1162, 1327, 1329, 1366, 1369,
+		1369, 1376, 1416, 1488, 1514, 1519, 1522, 1568, 1610, 1646, 1647, 1649,
+		1747, 1749, 1749, 1765, 1766, 1774, 1775, 1786, 1788, 1791, 1791, 1808,
+		1808, 1810, 1839, 1869, 1957, 1969, 1969, 1994, 2026, 2036, 2037, 2042,
+		2042, 2048, 2069, 2074, 2074, 2084, 2084, 2088, 2088, 2112, 2136, 2144,
+		2154, 2208, 2228, 2230, 2247, 2308, 2361, 2365, 2365, 2384, 2384, 2392,
+		2401, 2417, 2432, 2437, 2444, 2447, 2448, 2451, 2472, 2474, 2480, 2482,
+		2482, 2486, 2489, 2493, 2493, 2510, 2510, 2524, 2525, 2527, 2529, 2544,
+		2545, 2556, 2556, 2565, 2570, 2575, 2576, 2579, 2600, 2602, 2608, 2610,
+		2611, 2613, 2614, 2616, 2617, 2649, 2652, 2654, 2654, 2674, 2676, 2693,
+		2701, 2703, 2705, 2707, 2728, 2730, 2736, 2738, 2739, 2741, 2745, 2749,
+		2749, 2768, 2768, 2784, 2785, 2809, 2809, 2821, 2828, 2831, 2832, 2835,
+		2856, 2858, 2864, 2866, 2867, 2869, 2873, 2877, 2877, 2908, 2909, 2911,
+		2913, 2929, 2929, 2947, 2947, 2949, 2954, 2958, 2960, 2962, 2965, 2969,
+		2970, 2972, 2972, 2974, 2975, 2979, 2980, 2984, 2986, 2990, 3001, 3024,
+		3024, 3077, 3084, 3086, 3088, 3090, 3112, 3114, 3129, 3133, 3133, 3160,
+		3162, 3168, 3169, 3200, 3200, 3205, 3212, 3214, 3216, 3218, 3240, 3242,
+		3251, 3253, 3257, 3261, 3261, 3294, 3294, 3296, 3297, 3313, 3314, 3332,
+		3340, 3342, 3344, 3346, 3386, 3389, 3389, 3406, 3406, 3412, 3414, 3423,
+		3425, 3450, 3455, 3461, 3478, 3482, 3505, 3507, 3515, 3517, 3517, 3520,
+		3526, 3585, 3632, 3634, 3635, 3648, 3654, 3713, 3714, 3716, 3716, 3718,
+		3722, 3724, 3747, 3749, 3749, 3751, 3760, 3762, 3763, 3773, 3773, 3776,
+		3780, 3782, 3782, 3804, 3807, 3840, 3840, 3904, 3911, 3913, 3948, 3976,
+		3980, 4096, 4138, 4159, 4159, 4176, 4181, 4186, 4189, 4193, 4193, 4197,
+		4198, 4206, 4208, 4213, 4225, 4238, 4238, 4256, 4293, 4295, 4295, 4301,
+		4301, 4304, 4346, 4348, 4680, 4682, 4685, 4688, 4694, 4696, 4696, 4698,
+		4701, 4704, 4744, 4746, 4749, 4752, 4784, 4786, 4789, 4792, 4798, 4800,
+		4800, 4802, 4805, 4808, 4822, 4824, 4880, 4882, 4885, 4888, 4954, 4992,
+		5007, 5024, 5109, 5112, 5117, 5121, 5740, 5743, 5759, 5761, 5786, 5792,
+		5866, 5873, 5880, 5888, 5900, 5902, 5905, 5920, 5937, 5952, 5969, 5984,
+		5996, 5998, 6000, 6016, 6067, 6103, 6103, 6108, 6108, 6176, 6264, 6272,
+		6276, 6279, 6312, 6314, 6314, 6320, 6389, 6400, 6430, 6480, 6509, 6512,
+		6516, 6528, 6571, 6576, 6601, 6656, 6678, 6688, 6740, 6823, 6823, 6917,
+		6963, 6981, 6987, 7043, 7072, 7086, 7087, 7098, 7141, 7168, 7203, 7245,
+		7247, 7258, 7293, 7296, 7304, 7312, 7354, 7357, 7359, 7401, 7404, 7406,
+		7411, 7413, 7414, 7418, 7418, 7424, 7615, 7680, 7957, 7960, 7965, 7968,
+		8005, 8008, 8013, 8016, 8023, 8025, 8025, 8027, 8027, 8029, 8029, 8031,
+		8061, 8064, 8116, 8118, 8124, 8126, 8126, 8130, 8132, 8134, 8140, 8144,
+		8147, 8150, 8155, 8160, 8172, 8178, 8180, 8182, 8188, 8305, 8305, 8319,
+		8319, 8336, 8348, 8450, 8450, 8455, 8455, 8458, 8467, 8469, 8469, 8473,
+		8477, 8484, 8484, 8486, 8486, 8488, 8488, 8490, 8493, 8495, 8505, 8508,
+		8511, 8517, 8521, 8526, 8526, 8579, 8580, 11264, 11310, 11312, 11358, 11360,
+		11492, 11499, 11502, 11506, 11507, 11520, 11557, 11559, 11559, 11565, 11565,
+		11568, 11623, 11631, 11631, 11648, 11670, 11680, 11686, 11688, 11694, 11696,
+		11702, 11704, 11710, 11712, 11718, 11720, 11726, 11728, 11734, 11736, 11742,
+		11823, 11823, 12293, 12294, 12337, 12341, 12347, 12348, 12353, 12438, 12445,
+		12447, 12449, 12538, 12540, 12543, 12549, 12591, 12593, 12686, 12704, 12735,
+		12784, 12799, 13312, 19903, 19968, 40956, 40960, 42124, 42192, 42237, 42240,
+		42508, 42512, 42527, 42538, 42539, 42560, 42606, 42623, 42653, 42656, 42725,
+		42775, 42783, 42786, 42888, 42891, 42943, 42946, 42954, 42997, 43009, 43011,
+		43013, 43015, 43018, 43020, 43042, 43072, 43123, 43138, 43187, 43250, 43255,
+		43259, 43259, 43261, 43262, 43274, 43301, 43312, 43334, 43360, 43388, 43396,
+		43442, 43471, 43471, 43488, 43492, 43494, 43503, 43514, 43518, 43520, 43560,
+		43584, 43586, 43588, 43595, 43616, 43638, 43642, 43642, 43646, 43695, 43697,
+		43697, 43701, 43702, 43705, 43709, 43712, 43712, 43714, 43714, 43739, 43741,
+		43744, 43754, 43762, 43764, 43777, 43782, 43785, 43790, 43793, 43798, 43808,
+		43814, 43816, 43822, 43824, 43866, 43868, 43881, 43888, 44002, 44032, 55203,
+		55216, 55238, 55243, 55291, 63744, 64109, 64112, 64217, 64256, 64262, 64275,
+		64279, 64285, 64285, 64287, 64296, 64298, 64310, 64312, 64316, 64318, 64318,
+		64320, 64321, 64323, 64324, 64326, 64433, 64467, 64829, 64848, 64911, 64914,
+		64967, 65008, 65019, 65136, 65140, 65142, 65276, 65313, 65338, 65345, 65370,
+		65382, 65470, 65474, 65479, 65482, 65487, 65490, 65495, 65498, 65500, 65536,
+		65547, 65549, 65574, 65576, 65594, 65596, 65597, 65599, 65613, 65616, 65629,
+		65664, 65786, 66176, 66204, 66208, 66256, 66304, 66335, 66349, 66368, 66370,
+		66377, 66384, 66421, 66432, 66461, 66464, 66499, 66504, 66511, 66560, 66717,
+		66736, 66771, 66776, 66811, 66816, 66855, 66864, 66915, 67072, 67382, 67392,
+		67413, 67424, 67431, 67584, 67589, 67592, 67592, 67594, 67637, 67639, 67640,
+		67644, 67644, 67647, 67669, 67680, 67702, 67712, 67742, 67808, 67826, 67828,
+		67829, 67840, 67861, 67872, 67897, 67968, 68023, 68030, 68031, 68096, 68096,
+		68112, 68115, 68117, 68119, 68121, 68149, 68192, 68220, 68224, 68252, 68288,
+		68295, 68297, 68324, 68352, 68405, 68416, 68437, 68448, 68466, 68480, 68497,
+		68608, 68680, 68736, 68786, 68800, 68850, 68864, 68899, 69248, 69289, 69296,
+		69297, 69376, 69404, 69415, 69415, 69424, 69445, 69552, 69572, 69600, 69622,
+		69635, 69687, 69763, 69807, 69840, 69864, 69891, 69926, 69956, 69956, 69959,
+		69959, 69968, 70002, 70006, 70006, 70019, 70066, 70081, 70084, 70106, 70106,
+		70108, 70108, 70144, 70161, 70163, 70187, 70272, 70278, 70280, 70280, 70282,
+		70285, 70287, 70301, 70303, 70312, 70320, 70366, 70405, 70412, 70415, 70416,
+		70419, 70440, 70442, 70448, 70450, 70451, 70453, 70457, 70461, 70461, 70480,
+		70480, 70493, 70497, 70656, 70708, 70727, 70730, 70751, 70753, 70784, 70831,
+		70852, 70853, 70855, 70855, 71040, 71086, 71128, 71131, 71168, 71215, 71236,
+		71236, 71296, 71338, 71352, 71352, 71424, 71450, 71680, 71723, 71840, 71903,
+		71935, 71942, 71945, 71945, 71948, 71955, 71957, 71958, 71960, 71983, 71999,
+		71999, 72001, 72001, 72096, 72103, 72106, 72144, 72161, 72161, 72163, 72163,
+		72192, 72192, 72203, 72242, 72250, 72250, 72272, 72272, 72284, 72329, 72349,
+		72349, 72384, 72440, 72704, 72712, 72714, 72750, 72768, 72768, 72818, 72847,
+		72960, 72966, 72968, 72969, 72971, 73008, 73030, 73030, 73056, 73061, 73063,
+		73064, 73066, 73097, 73112, 73112, 73440, 73458, 73648, 73648, 73728, 74649,
+		74880, 75075, 77824, 78894, 82944, 83526, 92160, 92728, 92736, 92766, 92880,
+		92909, 92928, 92975, 92992, 92995, 93027, 93047, 93053, 93071, 93760, 93823,
+		93952, 94026, 94032, 94032, 94099, 94111, 94176, 94177, 94179, 94179, 94208,
+		100343, 100352, 101589, 101632, 101640, 110592, 110878, 110928, 110930,
+		110948, 110951, 110960, 111355, 113664, 113770, 113776, 113788, 113792,
+		113800, 113808, 113817, 119808, 119892, 119894, 119964, 119966, 119967,
+		119970, 119970, 119973, 119974, 119977, 119980, 119982, 119993, 119995,
+		119995, 119997, 120003, 120005, 120069, 120071, 120074, 120077, 120084,
+		120086, 120092, 120094, 120121, 120123, 120126, 120128, 120132, 120134,
+		120134, 120138, 120144, 120146, 120485, 120488, 120512, 120514, 120538,
+		120540, 120570, 120572, 120596, 120598, 120628, 120630, 120654, 120656,
+		120686, 120688, 120712, 120714, 120744, 120746, 120770, 120772, 120779,
+		123136, 123180, 123191, 123197, 123214, 123214, 123584, 123627, 124928,
+		125124, 125184, 125251, 125259, 125259, 126464, 126467, 126469, 126495,
+		126497, 126498, 126500, 126500, 126503, 126503, 126505, 126514, 126516,
+		126519, 126521, 126521, 126523, 126523, 126530, 126530, 126535, 126535,
+		126537, 126537, 126539, 126539, 126541, 126543, 126545, 126546, 126548,
+		126548, 126551, 126551, 126553, 126553, 126555, 126555, 126557, 126557,
+		126559, 126559, 126561, 126562, 126564, 126564, 126567, 126570, 126572,
+		126578, 126580, 126583, 126585, 126588, 126590, 126590, 126592, 126601,
+		126603, 126619, 126625, 126627, 126629, 126633, 126635, 126651, 131072,
+		173789, 173824, 177972, 177984, 178205, 178208, 183969, 183984, 191456,
+		194560, 195101, 196608, 201546, 887, 0, 2, 1, 0, 0, 0, 0, 4, 1, 0, 0, 0,
+		0, 6, 1, 0, 0, 0, 0, 8, 1, 0, 0, 0, 0, 10, 1, 0, 0, 0, 0, 12, 1, 0, 0,
+		0, 0, 14, 1, 0, 0, 0, 0, 16, 1, 0, 0, 0, 0, 18, 1, 0, 0, 0, 0, 20, 1, 0,
+		0, 0, 0, 22, 1, 0, 0, 0, 0, 24, 1, 0, 0, 0, 0, 26, 1, 0, 0, 0, 0, 28, 1,
+		0, 0, 0, 0, 30, 1, 0, 0, 0, 0, 32, 1, 0, 0, 0, 0, 34, 1, 0, 0, 0, 0, 36,
+		1, 0, 0, 0, 0, 38, 1, 0, 0, 0, 0, 40, 1, 0, 0, 0, 0, 42, 1, 0, 0, 0, 0,
+		44, 1, 0, 0, 0, 0, 46, 1, 0, 0, 0, 0, 48, 1, 0, 0, 0, 0, 50, 1, 0, 0, 0,
+		0, 52, 1, 0, 0, 0, 0, 54, 1, 0, 0, 0, 0, 56, 1, 0, 0, 0, 0, 58, 1, 0, 0,
+		0, 0, 60, 1, 0, 0, 0, 0, 62, 1, 0, 0, 0, 0, 64, 1, 0, 0, 0, 0, 66, 1, 0,
+		0, 0, 0, 68, 1, 0, 0, 0, 0, 70, 1, 0, 0, 0, 0, 72, 1, 0, 0, 0, 0, 74, 1,
+		0, 0, 0, 0, 76, 1, 0, 0, 0, 0, 78, 1, 0, 0, 0, 0, 80, 1, 0, 0, 0, 0, 82,
+		1, 0, 0, 0, 0, 84, 1, 0, 0, 0, 0, 86, 1, 0, 0, 0, 0, 88, 1, 0, 0, 0, 0,
+		90, 1, 0, 0, 0, 0, 92, 1, 0, 0, 0, 0, 94, 1, 0, 0, 0, 0, 96, 1, 0, 0, 0,
+		0, 98, 1, 0, 0, 0, 0, 100, 1, 0, 0, 0, 0, 102, 1, 0, 0, 0, 0, 104, 1, 0,
+		0, 0, 0, 106, 1, 0, 0, 0, 0, 108, 1, 0, 0, 0, 0, 110, 1, 0, 0, 0, 0, 112,
+		1, 0, 0, 0, 0, 114, 1, 0, 0, 0, 0, 116, 1, 0, 0, 0, 0, 118, 1, 0, 0, 0,
+		0, 120, 1, 0, 0, 0, 0, 122, 1, 0, 0, 0, 0, 124, 1, 0, 0, 0, 0, 126, 1,
+		0, 0, 0, 0, 128, 1, 0, 0, 0, 0, 130, 1, 0, 0, 0, 0, 132, 1, 0, 0, 0, 0,
+		134, 1, 0, 0, 0, 0, 136, 1, 0, 0, 0, 0, 138, 1, 0, 0, 0, 0, 140, 1, 0,
+		0, 0, 0, 142, 1, 0, 0, 0, 0, 148, 1, 0, 0, 0, 0, 152, 1, 0, 0, 0, 0, 154,
+		1, 0, 0, 0, 0, 156, 1, 0, 0, 0, 0, 158, 1, 0, 0, 0, 0, 160, 1, 0, 0, 0,
+		0, 162, 1, 0, 0, 0, 0, 164, 1, 0, 0, 0, 0, 166, 1, 0, 0, 0, 0, 168, 1,
+		0, 0, 0, 0, 170, 1, 0, 0, 0, 0, 172, 1, 0, 0, 0, 0, 174, 1, 0, 0, 0, 1,
+		196, 1, 0, 0, 0, 1, 198, 1, 0, 0, 0, 1, 200, 1, 0, 0, 0, 1, 202, 1, 0,
+		0, 0, 1, 204, 1, 0, 0, 0, 2, 206, 1, 0, 0, 0, 4, 214, 1, 0, 0, 0, 6, 222,
+		1, 0, 0, 0, 8, 227, 1, 0, 0, 0, 10, 237, 1, 0, 0, 0, 12, 244, 1, 0, 0,
+		0, 14, 249, 1, 0, 0, 0, 16, 255, 1, 0, 0, 0, 18, 258, 1, 0, 0, 0, 20, 262,
+		1, 0, 0, 0, 22, 269, 1, 0, 0, 0, 24, 274, 1, 0, 0, 0, 26, 279, 1, 0, 0,
+		0, 28, 284, 1, 0, 0, 0, 30, 292, 1, 0, 0, 0, 32, 299, 1, 0, 0, 0, 34, 305,
+		1, 0, 0, 0, 36, 319, 1, 0, 0, 0, 38, 322, 1, 0, 0, 0, 40, 328, 1, 0, 0,
+		0, 42, 333, 1, 0, 0, 0, 44, 344, 1, 0, 0, 0, 46, 348, 1, 0, 0, 0, 48, 355,
+		1, 0, 0, 0, 50, 364, 1, 0, 0, 0, 52, 368, 1, 0, 0, 0, 54, 374, 1, 0, 0,
+		0, 56, 384, 1, 0, 0, 0, 58, 386, 1, 0, 0, 0, 60, 390, 1, 0, 0, 0, 62, 392,
+		1, 0, 0, 0, 64, 396, 1, 0, 0, 0, 66, 398, 1, 0, 0, 0, 68, 402, 1, 0, 0,
+		0, 70, 404, 1, 0, 0, 0, 72, 406, 1, 0, 0, 0, 74, 408, 1, 0, 0, 0, 76, 410,
+		1, 0, 0, 0, 78, 412, 1, 0, 0, 0, 80, 417, 1, 0, 0, 0, 82, 422, 1, 0, 0,
+		0, 84, 425, 1, 0, 0, 0, 86, 429, 1, 0, 0, 0, 88, 432, 1, 0, 0, 0, 90, 435,
+		1, 0, 0, 0, 92, 438, 1, 0, 0, 0, 94, 441, 1, 0, 0, 0, 96, 443, 1, 0, 0,
+		0, 98, 446, 1, 0, 0, 0, 100, 448, 1, 0, 0, 0, 102, 451, 1, 0, 0, 0, 104,
+		453, 1, 0, 0, 0, 106, 455, 1, 0, 0, 0, 108, 457, 1, 0, 0, 0, 110, 460,
+		1, 0, 0, 0, 112, 463, 1, 0, 0, 0, 114, 466, 1, 0, 0, 0, 116, 468, 1, 0,
+		0, 0, 118, 470, 1, 0, 0, 0, 120, 472, 1, 0, 0, 0, 122, 474, 1, 0, 0, 0,
+		124, 476, 1, 0, 0, 0, 126, 478, 1, 0, 0, 0, 128, 481, 1, 0, 0, 0, 130,
+		494, 1, 0, 0, 0, 132, 498, 1, 0, 0, 0, 134, 510, 1, 0, 0, 0, 136, 524,
+		1, 0, 0, 0, 138, 538, 1, 0, 0, 0, 140, 558, 1, 0, 0, 0, 142, 560, 1, 0,
+		0, 0, 144, 596, 1, 0, 0, 0, 146, 598, 1, 0, 0, 0, 148, 609, 1, 0, 0, 0,
+		150, 615, 1, 0, 0, 0, 152, 622, 1, 0, 0, 0, 154, 628, 1, 0, 0, 0, 156,
+		630, 1, 0, 0, 0, 158, 635, 1, 0, 0, 0, 160, 640, 1, 0, 0, 0, 162, 647,
+		1, 0, 0, 0, 164, 658, 1, 0, 0, 0, 166, 669, 1, 0, 0, 0, 168, 682, 1, 0,
+		0, 0, 170, 688, 1, 0, 0, 0, 172, 703, 1, 0, 0, 0, 174, 709, 1, 0, 0, 0,
+		176, 724, 1, 0, 0, 0, 178, 726, 1, 0, 0, 0, 180, 754, 1, 0, 0, 0, 182,
+		764, 1, 0, 0, 0, 184, 766, 1, 0, 0, 0, 186, 768, 1, 0, 0, 0, 188, 770,
+		1, 0, 0, 0, 190, 778, 1, 0, 0, 0, 192, 780, 1, 0, 0, 0, 194, 782, 1, 0,
+		0, 0, 196, 785, 1, 0, 0, 0, 198, 791, 1, 0, 0, 0, 200, 805, 1, 0, 0, 0,
+		202, 834, 1, 0, 0, 0, 204, 838, 1, 0, 0, 0, 206, 207, 5, 98, 0, 0, 207,
+		208, 5, 114, 0, 0, 208, 209, 5, 101, 0, 0, 209, 210, 5, 97, 0, 0, 210,
+		211, 5, 107, 0, 0, 211, 212, 1, 0, 0, 0, 212, 213, 6, 0, 0, 0, 213, 3,
+		1, 0, 0, 0, 214, 215, 5, 100, 0, 0, 215, 216, 5, 101, 0, 0, 216, 217, 5,
+		102, 0, 0, 217, 218, 5, 97, 0, 0, 218, 219, 5, 117, 0, 0, 219, 220, 5,
+		108, 0, 0, 220, 221, 5, 116, 0, 0, 221, 5, 1, 0, 0, 0, 222, 223, 5, 102,
+		0, 0, 223, 224, 5, 117, 0, 0, 224, 225, 5, 110, 0, 0, 225, 226, 5, 99,
+		0, 0, 226, 7, 1, 0, 0, 0, 227, 228, 5, 105, 0, 0, 228, 229, 5, 110, 0,
+		0, 229, 230, 5, 116, 0, 0, 230, 231, 5, 101, 0, 0, 231, 232, 5, 114, 0,
+		0, 232, 233, 5, 102, 0, 0, 233, 234, 5, 97, 0, 0, 234, 235, 5, 99, 0, 0,
+		235, 236, 5, 101, 0, 0, 236, 9, 1, 0, 0, 0, 237, 238, 5, 115, 0, 0, 238,
+		239, 5, 101, 0, 0, 239, 240, 5, 108, 0, 0, 240, 241, 5, 101, 0, 0, 241,
+		242, 5, 99, 0, 0, 242, 243, 5, 116, 0, 0, 243, 11, 1, 0, 0, 0, 244, 245,
+		5, 99, 0, 0, 245, 246, 5, 97, 0, 0, 246, 247, 5, 115, 0, 0, 247, 248, 5,
+		101, 0, 0, 248, 13, 1, 0, 0, 0, 249, 250, 5, 100, 0, 0, 250, 251, 5, 101,
+		0, 0, 251, 252, 5, 102, 0, 0, 252, 253, 5, 101, 0, 0, 253, 254, 5, 114,
+		0, 0, 254, 15, 1, 0, 0, 0, 255, 256, 5, 103, 0, 0, 256, 257, 5, 111, 0,
+		0, 257, 17, 1, 0, 0, 0, 258, 259, 5, 109, 0, 0, 259, 260, 5, 97, 0, 0,
+		260, 261, 5, 112, 0, 0, 261, 19, 1, 0, 0, 0, 262, 263, 5, 115, 0, 0, 263,
+		264, 5, 116, 0, 0, 264, 265, 5, 114, 0, 0, 265, 266, 5, 117, 0, 0, 266,
+		267, 5, 99, 0, 0, 267, 268, 5, 116, 0, 0, 268, 21, 1, 0, 0, 0, 269, 270,
+		5, 99, 0, 0, 270, 271, 5, 104, 0, 0, 271, 272, 5, 97, 0, 0, 272, 273, 5,
+		110, 0, 0, 273, 23, 1, 0, 0, 0, 274, 275, 5, 101, 0, 0, 275, 276, 5, 108,
+		0, 0, 276, 277, 5, 115, 0, 0, 277, 278, 5, 101, 0, 0, 278, 25, 1, 0, 0,
+		0, 279, 280, 5, 103, 0, 0, 280, 281, 5, 111, 0, 0, 281, 282, 5, 116, 0,
+		0, 282, 283, 5, 111, 0, 0, 283, 27, 1, 0, 0, 0, 284, 285, 5, 112, 0, 0,
+		285, 286, 5, 97, 0, 0, 286, 287, 5, 99, 0, 0, 287, 288, 5, 107, 0, 0, 288,
+		289, 5, 97, 0, 0, 289, 290, 5, 103, 0, 0, 290, 291, 5, 101, 0, 0, 291,
+		29, 1, 0, 0, 0, 292, 293, 5, 115, 0, 0, 293, 294, 5, 119, 0, 0, 294, 295,
+		5, 105, 0, 0, 295, 296, 5, 116, 0, 0, 296, 297, 5, 99, 0, 0, 297, 298,
+		5, 104, 0, 0, 298, 31, 1, 0, 0, 0, 299, 300, 5, 99, 0, 0, 300, 301, 5,
+		111, 0, 0, 301, 302, 5, 110, 0, 0, 302, 303, 5, 115, 0, 0, 303, 304, 5,
+		116, 0, 0, 304, 33, 1, 0, 0, 0, 305, 306, 5, 102, 0, 0, 306, 307, 5, 97,
+		0, 0, 307, 308, 5, 108, 0, 0, 308, 309, 5, 108, 0, 0, 309, 310, 5, 116,
+		0, 0, 310, 311, 5, 104, 0, 0, 311, 312, 5, 114, 0, 0, 312, 313, 5, 111,
+		0, 0, 313, 314, 5, 117, 0, 0, 314, 315, 5, 103, 0, 0, 315, 316, 5, 104,
+		0, 0, 316, 317, 1, 0, 0, 0, 317, 318, 6, 16, 0, 0, 318, 35, 1, 0, 0, 0,
+		319, 320, 5, 105, 0, 0, 320, 321, 5, 102, 0, 0, 321, 37, 1, 0, 0, 0, 322,
+		323, 5, 114, 0, 0, 323, 324, 5, 97, 0, 0, 324, 325, 5, 110, 0, 0, 325,
+		326, 5, 103, 0, 0, 326, 327, 5, 101, 0, 0, 327, 39, 1, 0, 0, 0, 328, 329,
+		5, 116, 0, 0, 329, 330, 5, 121, 0, 0, 330, 331, 5, 112, 0, 0, 331, 332,
+		5, 101, 0, 0, 332, 41, 1, 0, 0, 0, 333, 334, 5, 99, 0, 0, 334, 335, 5,
+		111, 0, 0, 335, 336, 5, 110, 0, 0, 336, 337, 5, 116, 0, 0, 337, 338, 5,
+		105, 0, 0, 338, 339, 5, 110, 0, 0, 339, 340, 5, 117, 0, 0, 340, 341, 5,
+		101, 0, 0, 341, 342, 1, 0, 0, 0, 342, 343, 6, 20, 0, 0, 343, 43, 1, 0,
+		0, 0, 344, 345, 5, 102, 0, 0, 345, 346, 5, 111, 0, 0, 346, 347, 5, 114,
+		0, 0, 347, 45, 1, 0, 0, 0, 348, 349, 5, 105, 0, 0, 349, 350, 5, 109, 0,
+		0, 350, 351, 5, 112, 0, 0, 351, 352, 5, 111, 0, 0, 352, 353, 5, 114, 0,
+		0, 353, 354, 5, 116, 0, 0, 354, 47, 1, 0, 0, 0, 355, 356, 5, 114, 0, 0,
+		356, 357, 5, 101, 0, 0, 357, 358, 5, 116, 0, 0, 358, 359, 5, 117, 0, 0,
+		359, 360, 5, 114, 0, 0, 360, 361, 5, 110, 0, 0, 361, 362, 1, 0, 0, 0, 362,
+		363, 6, 23, 0, 0, 363, 49, 1, 0, 0, 0, 364, 365, 5, 118, 0, 0, 365, 366,
+		5, 97, 0, 0, 366, 367, 5, 114, 0, 0, 367, 51, 1, 0, 0, 0, 368, 369, 5,
+		110, 0, 0, 369, 370, 5, 105, 0, 0, 370, 371, 5, 108, 0, 0, 371, 372, 1,
+		0, 0, 0, 372, 373, 6, 25, 0, 0, 373, 53, 1, 0, 0, 0, 374, 379, 3, 190,
+		93, 0, 375, 378, 3, 190, 93, 0, 376, 378, 3, 192, 94, 0, 377, 375, 1, 0,
+		0, 0, 377, 376, 1, 0, 0, 0, 378, 381, 1, 0, 0, 0, 379, 377, 1, 0, 0, 0,
+		379, 380, 1, 0, 0, 0, 380, 382, 1, 0, 0, 0, 381, 379, 1, 0, 0, 0, 382,
+		383, 6, 26, 0, 0, 383, 55, 1, 0, 0, 0, 384, 385, 5, 40, 0, 0, 385, 57,
+		1, 0, 0, 0, 386, 387, 5, 41, 0, 0, 387, 388, 1, 0, 0, 0, 388, 389, 6, 28,
+		0, 0, 389, 59, 1, 0, 0, 0, 390, 391, 5, 123, 0, 0, 391, 61, 1, 0, 0, 0,
+		392, 393, 5, 125, 0, 0, 393, 394, 1, 0, 0, 0, 394, 395, 6, 30, 0, 0, 395,
+		63, 1, 0, 0, 0, 396, 397, 5, 91, 0, 0, 397, 65, 1, 0, 0, 0, 398, 399, 5,
+		93, 0, 0, 399, 400, 1, 0, 0, 0, 400, 401, 6, 32, 0, 0, 401, 67, 1, 0, 0,
+		0, 402, 403, 5, 61, 0, 0, 403, 69, 1, 0, 0, 0, 404, 405, 5, 44, 0, 0, 405,
+		71, 1, 0, 0, 0, 406, 407, 5, 59, 0, 0, 407, 73, 1, 0, 0, 0, 408, 409, 5,
+		58, 0, 0, 409, 75, 1, 0, 0, 0, 410, 411, 5, 46, 0, 0, 411, 77, 1, 0, 0,
+		0, 412, 413, 5, 43, 0, 0, 413, 414, 5, 43, 0, 0, 414, 415, 1, 0, 0, 0,
+		415, 416, 6, 38, 0, 0, 416, 79, 1, 0, 0, 0, 417, 418, 5, 45, 0, 0, 418,
+		419, 5, 45, 0, 0, 419, 420, 1, 0, 0, 0, 420, 421, 6, 39, 0, 0, 421, 81,
+		1, 0, 0, 0, 422, 423, 5, 58, 0, 0, 423, 424, 5, 61, 0, 0, 424, 83, 1, 0,
+		0, 0, 425, 426, 5, 46, 0, 0, 426, 427, 5, 46, 0, 0, 427, 428, 5, 46, 0,
+		0, 428, 85, 1, 0, 0, 0, 429, 430, 5, 124, 0, 0, 430, 431, 5, 124, 0, 0,
+		431, 87, 1, 0, 0, 0, 432, 433, 5, 38, 0, 0, 433, 434, 5, 38, 0, 0, 434,
+		89, 1, 0, 0, 0, 435, 436, 5, 61, 0, 0, 436, 437, 5, 61, 0, 0, 437, 91,
+		1, 0, 0, 0, 438, 439, 5, 33, 0, 0, 439, 440, 5, 61, 0, 0, 440, 93, 1, 0,
+		0, 0, 441, 442, 5, 60, 0, 0, 442, 95, 1, 0, 0, 0, 443, 444, 5, 60, 0, 0,
+		444, 445, 5, 61, 0, 0, 445, 97, 1, 0, 0, 0, 446, 447, 5, 62, 0, 0, 447,
+		99, 1, 0, 0, 0, 448, 449, 5, 62, 0, 0, 449, 450, 5, 61, 0, 0, 450, 101,
+		1, 0, 0, 0, 451, 452, 5, 124, 0, 0, 452, 103, 1, 0, 0, 0, 453, 454, 5,
+		47, 0, 0, 454, 105, 1, 0, 0, 0, 455, 456, 5, 37, 0, 0, 456, 107, 1, 0,
+		0, 0, 457, 458, 5, 60, 0, 0, 458, 459, 5, 60, 0, 0, 459, 109, 1, 0, 0,
+		0, 460, 461, 5, 62, 0, 0, 461, 462, 5, 62, 0, 0, 462, 111, 1, 0, 0, 0,
+		463, 464, 5, 38, 0, 0, 464, 465, 5, 94, 0, 0, 465, 113, 1, 0, 0, 0, 466,
+		467, 5, 33, 0, 0, 467, 115, 1, 0, 0, 0, 468, 469, 5, 43, 0, 0, 469, 117,
+		1, 0, 0, 0, 470, 471, 5, 45, 0, 0, 471, 119, 1, 0, 0, 0, 472, 473, 5, 94,
+		0, 0, 473, 121, 1, 0, 0, 0, 474, 475, 5, 42, 0, 0, 475, 123, 1, 0, 0, 0,
+		476, 477, 5, 38, 0, 0, 477, 125, 1, 0, 0, 0, 478, 479, 5, 60, 0, 0, 479,
+		480, 5, 45, 0, 0, 480, 127, 1, 0, 0, 0, 481, 482, 5, 126, 0, 0, 482, 129,
+		1, 0, 0, 0, 483, 495, 5, 48, 0, 0, 484, 491, 7, 0, 0, 0, 485, 487, 5, 95,
+		0, 0, 486, 485, 1, 0, 0, 0, 486, 487, 1, 0, 0, 0, 487, 488, 1, 0, 0, 0,
+		488, 490, 7, 1, 0, 0, 489, 486, 1, 0, 0, 0, 490, 493, 1, 0, 0, 0, 491,
+		489, 1, 0, 0, 0, 491, 492, 1, 0, 0, 0, 492, 495, 1, 0, 0, 0, 493, 491,
+		1, 0, 0, 0, 494, 483, 1, 0, 0, 0, 494, 484, 1, 0, 0, 0, 495, 496, 1, 0,
+		0, 0, 496, 497, 6, 64, 0, 0, 497, 131, 1, 0, 0, 0, 498, 499, 5, 48, 0,
+		0, 499, 504, 7, 2, 0, 0, 500, 502, 5, 95, 0, 0, 501, 500, 1, 0, 0, 0, 501,
+		502, 1, 0, 0, 0, 502, 503, 1, 0, 0, 0, 503, 505, 3, 186, 91, 0, 504, 501,
+		1, 0, 0, 0, 505, 506, 1, 0, 0, 0, 506, 504, 1, 0, 0, 0, 506, 507, 1, 0,
+		0, 0, 507, 508, 1, 0, 0, 0, 508, 509, 6, 65, 0, 0, 509, 133, 1, 0, 0, 0,
+		510, 512, 5, 48, 0, 0, 511, 513, 7, 3, 0, 0, 512, 511, 1, 0, 0, 0, 512,
+		513, 1, 0, 0, 0, 513, 518, 1, 0, 0, 0, 514, 516, 5, 95, 0, 0, 515, 514,
+		1, 0, 0, 0, 515, 516, 1, 0, 0, 0, 516, 517, 1, 0, 0, 0, 517, 519, 3, 182,
+		89, 0, 518, 515, 1, 0, 0, 0, 519, 520, 1, 0, 0, 0, 520, 518, 1, 0, 0, 0,
+		520, 521, 1, 0, 0, 0, 521, 522, 1, 0, 0, 0, 522, 523, 6, 66, 0, 0, 523,
+		135, 1, 0, 0, 0, 524, 525, 5, 48, 0, 0, 525, 530, 7, 4, 0, 0, 526, 528,
+		5, 95, 0, 0, 527, 526, 1, 0, 0, 0, 527, 528, 1, 0, 0, 0, 528, 529, 1, 0,
+		0, 0, 529, 531, 3, 184, 90, 0, 530, 527, 1, 0, 0, 0, 531, 532, 1, 0, 0,
+		0, 532, 530, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533, 534, 1, 0, 0, 0, 534,
+		535, 6, 67, 0, 0, 535, 137, 1, 0, 0, 0, 536, 539, 3, 140, 68, 0, 537, 539,
+		3, 142, 69, 0, 538, 536, 1, 0, 0, 0, 538, 537, 1, 0, 0, 0, 539, 540, 1,
+		0, 0, 0, 540, 541, 6, 68, 0, 0, 541, 139, 1, 0, 0, 0, 542, 551, 3, 180,
+		88, 0, 543, 545, 5, 46, 0, 0, 544, 546, 3, 180, 88, 0, 545, 544, 1, 0,
+		0, 0, 545, 546, 1, 0, 0, 0, 546, 548, 1, 0, 0, 0, 547, 549, 3, 188, 92,
+		0, 548, 547, 1, 0, 0, 0, 548, 549, 1, 0, 0, 0, 549, 552, 1, 0, 0, 0, 550,
+		552, 3, 188, 92, 0, 551, 543, 1, 0, 0, 0, 551, 550, 1, 0, 0, 0, 552, 559,
+		1, 0, 0, 0, 553, 554, 5, 46, 0, 0, 554, 556, 3, 180, 88, 0, 555, 557, 3,
+		188, 92, 0, 556, 555, 1, 0, 0, 0, 556, 557, 1, 0, 0, 0, 557, 559, 1, 0,
+		0, 0, 558, 542, 1, 0, 0, 0, 558, 553, 1, 0, 0, 0, 559, 141, 1, 0, 0, 0,
+		560, 561, 5, 48, 0, 0, 561, 562, 7, 4, 0, 0, 562, 563, 3, 144, 70, 0, 563,
+		564, 3, 146, 71, 0, 564, 143, 1, 0, 0, 0, 565, 567, 5, 95, 0, 0, 566, 565,
+		1, 0, 0, 0, 566, 567, 1, 0, 0, 0, 567, 568, 1, 0, 0, 0, 568, 570, 3, 184,
+		90, 0, 569, 566, 1, 0, 0, 0, 570, 571, 1, 0, 0, 0, 571, 569, 1, 0, 0, 0,
+		571, 572, 1, 0, 0, 0, 572, 583, 1, 0, 0, 0, 573, 580, 5, 46, 0, 0, 574,
+		576, 5, 95, 0, 0, 575, 574, 1, 0, 0, 0, 575, 576, 1, 0, 0, 0, 576, 577,
+		1, 0, 0, 0, 577, 579, 3, 184, 90, 0, 578, 575, 1, 0, 0, 0, 579, 582, 1,
+		0, 0, 0, 580, 578, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 584, 1, 0, 0,
+		0, 582, 580, 1, 0, 0, 0, 583, 573, 1, 0, 0, 0, 583, 584, 1, 0, 0, 0, 584,
+		597, 1, 0, 0, 0, 585, 586, 5, 46, 0, 0, 586, 593, 3, 184, 90, 0, 587, 589,
+		5, 95, 0, 0, 588, 587, 1, 0, 0, 0, 588, 589, 1, 0, 0, 0, 589, 590, 1, 0,
+		0, 0, 590, 592, 3, 184, 90, 0, 591, 588, 1, 0, 0, 0, 592, 595, 1, 0, 0,
+		0, 593, 591, 1, 0, 0, 0, 593, 594, 1, 0, 0, 0, 594, 597, 1, 0, 0, 0, 595,
+		593, 1, 0, 0, 0, 596, 569, 1, 0, 0, 0, 596, 585, 1, 0, 0, 0, 597, 145,
+		1, 0, 0, 0, 598, 600, 7, 5, 0, 0, 599, 601, 7, 6, 0, 0, 600, 599, 1, 0,
+		0, 0, 600, 601, 1, 0, 0, 0, 601, 602, 1, 0, 0, 0, 602, 603, 3, 180, 88,
+		0, 603, 147, 1, 0, 0, 0, 604, 610, 3, 130, 63, 0, 605, 610, 3, 132, 64,
+		0, 606, 610, 3, 134, 65, 0, 607, 610, 3, 136, 66, 0, 608, 610, 3, 138,
+		67, 0, 609, 604, 1, 0, 0, 0, 609, 605, 1, 0, 0, 0, 609, 606, 1, 0, 0, 0,
+		609, 607, 1, 0, 0, 0, 609, 608, 1, 0, 0, 0, 610, 611, 1, 0, 0, 0, 611,
+		612, 5, 105, 0, 0, 612, 613, 1, 0, 0, 0, 613, 614, 6, 73, 0, 0, 614, 149,
+		1, 0, 0, 0, 615, 618, 5, 39, 0, 0, 616, 619, 3, 176, 86, 0, 617, 619, 3,
+		154, 75, 0, 618, 616, 1, 0, 0, 0, 618, 617, 1, 0, 0, 0, 619, 620, 1, 0,
+		0, 0, 620, 621, 5, 39, 0, 0, 621, 151, 1, 0, 0, 0, 622, 623, 3, 150, 73,
+		0, 623, 624, 1, 0, 0, 0, 624, 625, 6, 75, 0, 0, 625, 153, 1, 0, 0, 0, 626,
+		629, 3, 156, 76, 0, 627, 629, 3, 158, 77, 0, 628, 626, 1, 0, 0, 0, 628,
+		627, 1, 0, 0, 0, 629, 155, 1, 0, 0, 0, 630, 631, 5, 92, 0, 0, 631, 632,
+		3, 182, 89, 0, 632, 633, 3, 182, 89, 0, 633, 634, 3, 182, 89, 0, 634, 157,
+		1, 0, 0, 0, 635, 636, 5, 92, 0, 0, 636, 637, 5, 120, 0, 0, 637, 638, 3,
+		184, 90, 0, 638, 639, 3, 184, 90, 0, 639, 159, 1, 0, 0, 0, 640, 641, 5,
+		92, 0, 0, 641, 642, 5, 117, 0, 0, 642, 643, 3, 184, 90, 0, 643, 644, 3,
+		184, 90, 0, 644, 645, 3, 184, 90, 0, 645, 646, 3, 184, 90, 0, 646, 161,
+		1, 0, 0, 0, 647, 648, 5, 92, 0, 0, 648, 649, 5, 85, 0, 0, 649, 650, 3,
+		184, 90, 0, 650, 651, 3, 184, 90, 0, 651, 652, 3, 184, 90, 0, 652, 653,
+		3, 184, 90, 0, 653, 654, 3, 184, 90, 0, 654, 655, 3, 184, 90, 0, 655, 656,
+		3, 184, 90, 0, 656, 657, 3, 184, 90, 0, 657, 163, 1, 0, 0, 0, 658, 662,
+		5, 96, 0, 0, 659, 661, 8, 7, 0, 0, 660, 659, 1, 0, 0, 0, 661, 664, 1, 0,
+		0, 0, 662, 660, 1, 0, 0, 0, 662, 663, 1, 0, 0, 0, 663, 665, 1, 0, 0, 0,
+		664, 662, 1, 0, 0, 0, 665, 666, 5, 96, 0, 0, 666, 667, 1, 0, 0, 0, 667,
+		668, 6, 81, 0, 0, 668, 165, 1, 0, 0, 0, 669, 674, 5, 34, 0, 0, 670, 673,
+		8, 8, 0, 0, 671, 673, 3, 178, 87, 0, 672, 670, 1, 0, 0, 0, 672, 671, 1,
+		0, 0, 0, 673, 676, 1, 0, 0, 0, 674, 672, 1, 0, 0, 0, 674, 675, 1, 0, 0,
+		0, 675, 677, 1, 0, 0, 0, 676, 674, 1, 0, 0, 0, 677, 678, 5, 34, 0, 0, 678,
+		679, 1, 0, 0, 0, 679, 680, 6, 82, 0, 0, 680, 167, 1, 0, 0, 0, 681, 683,
+		7, 9, 0, 0, 682, 681, 1, 0, 0, 0, 683, 684, 1, 0, 0, 0, 684, 682, 1, 0,
+		0, 0, 684, 685, 1, 0, 0, 0, 685, 686, 1, 0, 0, 0, 686, 687, 6, 83, 1, 0,
+		687, 169, 1, 0, 0, 0, 688, 689, 5, 47, 0, 0, 689, 690, 5, 42, 0, 0, 690,
+		694, 1, 0, 0, 0, 691, 693, 9, 0, 0, 0, 692, 691, 1, 0, 0, 0, 693, 696,
+		1, 0, 0, 0, 694, 695, 1, 0, 0, 0, 694, 692, 1, 0, 0, 0, 695, 697, 1, 0,
+		0, 0, 696, 694, 1, 0, 0, 0, 697, 698, 5, 42, 0, 0, 698, 699, 5, 47, 0,
+		0, 699, 700, 1, 0, 0, 0, 700, 701, 6, 84, 1, 0, 701, 171, 1, 0, 0, 0, 702,
+		704, 7, 10, 0, 0, 703, 702, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 703,
+		1, 0, 0, 0, 705, 706, 1, 0, 0, 0, 706, 707, 1, 0, 0, 0, 707, 708, 6, 85,
+		1, 0, 708, 173, 1, 0, 0, 0, 709, 710, 5, 47, 0, 0, 710, 711, 5, 47, 0,
+		0, 711, 715, 1, 0, 0, 0, 712, 714, 8, 10, 0, 0, 713, 712, 1, 0, 0, 0, 714,
+		717, 1, 0, 0, 0, 715, 713, 1, 0, 0, 0, 715, 716, 1, 0, 0, 0, 716, 718,
+		1, 0, 0, 0, 717, 715, 1, 0, 0, 0, 718, 719, 6, 86, 1, 0, 719, 175, 1, 0,
+		0, 0, 720, 725, 8, 11, 0, 0, 721, 725, 3, 160, 78, 0, 722, 725, 3, 162,
+		79, 0, 723, 725, 3, 178, 87, 0, 724, 720, 1, 0, 0, 0, 724, 721, 1, 0, 0,
+		0, 724, 722, 1, 0, 0, 0, 724, 723, 1, 0, 0, 0, 725, 177, 1, 0, 0, 0, 726,
+		752, 5, 92, 0, 0, 727, 728, 5, 117, 0, 0, 728, 729, 3, 184, 90, 0, 729,
+		730, 3, 184, 90, 0, 730, 731, 3, 184, 90, 0, 731, 732, 3, 184, 90, 0, 732,
+		753, 1, 0, 0, 0, 733, 734, 5, 85, 0, 0, 734, 735, 3, 184, 90, 0, 735, 736,
+		3, 184, 90, 0, 736, 737, 3, 184, 90, 0, 737, 738, 3, 184, 90, 0, 738, 739,
+		3, 184, 90, 0, 739, 740, 3, 184, 90, 0, 740, 741, 3, 184, 90, 0, 741, 742,
+		3, 184, 90, 0, 742, 753, 1, 0, 0, 0, 743, 753, 7, 12, 0, 0, 744, 745, 3,
+		182, 89, 0, 745, 746, 3, 182, 89, 0, 746, 747, 3, 182, 89, 0, 747, 753,
+		1, 0, 0, 0, 748, 749, 5, 120, 0, 0, 749, 750, 3, 184, 90, 0, 750, 751,
+		3, 184, 90, 0, 751, 753, 1, 0, 0, 0, 752, 727, 1, 0, 0, 0, 752, 733, 1,
+		0, 0, 0, 752, 743, 1, 0, 0, 0, 752, 744, 1, 0, 0, 0, 752, 748, 1, 0, 0,
+		0, 753, 179, 1, 0, 0, 0, 754, 761, 7, 1, 0, 0, 755, 757, 5, 95, 0, 0, 756,
+		755, 1, 0, 0, 0, 756, 757, 1, 0, 0, 0, 757, 758, 1, 0, 0, 0, 758, 760,
+		7, 1, 0, 0, 759, 756, 1, 0, 0, 0, 760, 763, 1, 0, 0, 0, 761, 759, 1, 0,
+		0, 0, 761, 762, 1, 0, 0, 0, 762, 181, 1, 0, 0, 0, 763, 761, 1, 0, 0, 0,
+		764, 765, 7, 13, 0, 0, 765, 183, 1, 0, 0, 0, 766, 767, 7, 14, 0, 0, 767,
+		185, 1, 0, 0, 0, 768, 769, 7, 15, 0, 0, 769, 187, 1, 0, 0, 0, 770, 772,
+		7, 16, 0, 0, 771, 773, 7, 6, 0, 0, 772, 771, 1, 0, 0, 0, 772, 773, 1, 0,
+		0, 0, 773, 774, 1, 0, 0, 0, 774, 775, 3, 180, 88, 0, 775, 189, 1, 0, 0,
+		0, 776, 779, 3, 194, 95, 0, 777, 779, 5, 95, 0, 0, 778, 776, 1, 0, 0, 0,
+		778, 777, 1, 0, 0, 0, 779, 191, 1, 0, 0, 0, 780, 781, 7, 17, 0, 0, 781,
+		193, 1, 0, 0, 0, 782, 783, 7, 18, 0, 0, 783, 195, 1, 0, 0, 0, 784, 786,
+		7, 9, 0, 0, 785, 784, 1, 0, 0, 0, 786, 787, 1, 0, 0, 0, 787, 785, 1, 0,
+		0, 0, 787, 788, 1, 0, 0, 0, 788, 789, 1, 0, 0, 0, 789, 790, 6, 97, 1, 0,
+		790, 197, 1, 0, 0, 0, 791, 792, 5, 47, 0, 0, 792, 793, 5, 42, 0, 0, 793,
+		797, 1, 0, 0, 0, 794, 796, 8, 10, 0, 0, 795, 794, 1, 0, 0, 0, 796, 799,
+		1, 0, 0, 0, 797, 798, 1, 0, 0, 0, 797, 795, 1, 0, 0, 0, 798, 800, 1, 0,
+		0, 0, 799, 797, 1, 0, 0, 0, 800, 801, 5, 42, 0, 0, 801, 802, 5, 47, 0,
+		0, 802, 803, 1, 0, 0, 0, 803, 804, 6, 98, 1, 0, 804, 199, 1, 0, 0, 0, 805,
+		806, 5, 47, 0, 0, 806, 807, 5, 47, 0, 0, 807, 811, 1, 0, 0, 0, 808, 810,
+		8, 10, 0, 0, 809, 808, 1, 0, 0, 0, 810, 813, 1, 0, 0, 0, 811, 809, 1, 0,
+		0, 0, 811, 812, 1, 0, 0, 0, 812, 814, 1, 0, 0, 0, 813, 811, 1, 0, 0, 0,
+		814, 815, 6, 99, 1, 0, 815, 201, 1, 0, 0, 0, 816, 818, 7, 10, 0, 0, 817,
+		816, 1, 0, 0, 0, 818, 819, 1, 0, 0, 0, 819, 817, 1, 0, 0, 0, 819, 820,
+		1, 0, 0, 0, 820, 835, 1, 0, 0, 0, 821, 835, 5, 59, 0, 0, 822, 823, 5, 47,
+		0, 0, 823, 824, 5, 42, 0, 0, 824, 828, 1, 0, 0, 0, 825, 827, 9, 0, 0, 0,
+		826, 825, 1, 0, 0, 0, 827, 830, 1, 0, 0, 0, 828, 829, 1, 0, 0, 0, 828,
+		826, 1, 0, 0, 0, 829, 831, 1, 0, 0, 0, 830, 828, 1, 0, 0, 0, 831, 832,
+		5, 42, 0, 0, 832, 835, 5, 47, 0, 0, 833, 835, 5, 0, 0, 1, 834, 817, 1,
+		0, 0, 0, 834, 821, 1, 0, 0, 0, 834, 822, 1, 0, 0, 0, 834, 833, 1, 0, 0,
+		0, 835, 836, 1, 0, 0, 0, 836, 837, 6, 100, 2, 0, 837, 203, 1, 0, 0, 0,
+		838, 839, 1, 0, 0, 0, 839, 840, 1, 0, 0, 0, 840, 841, 6, 101, 2, 0, 841,
+		842, 6, 101, 1, 0, 842, 205, 1, 0, 0, 0, 51, 0, 1, 377, 379, 486, 491,
+		494, 501, 506, 512, 515, 520, 527, 532, 538, 545, 548, 551, 556, 558, 566,
+		571, 575, 580, 583, 588, 593, 596, 600, 609, 618, 628, 662, 672, 674, 684,
+		694, 705, 715, 724, 752, 756, 761, 772, 778, 787, 797, 811, 819, 828, 834,
+		3, 2, 1, 0, 0, 1, 0, 2, 0, 0,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -696,31 +698,32 @@ const (
 	GoLexerSTAR                   = 61
 	GoLexerAMPERSAND              = 62
 	GoLexerRECEIVE                = 63
-	GoLexerDECIMAL_LIT            = 64
-	GoLexerBINARY_LIT             = 65
-	GoLexerOCTAL_LIT              = 66
-	GoLexerHEX_LIT                = 67
-	GoLexerFLOAT_LIT              = 68
-	GoLexerDECIMAL_FLOAT_LIT      = 69
-	GoLexerHEX_FLOAT_LIT          = 70
-	GoLexerIMAGINARY_LIT          = 71
-	GoLexerRUNE_LIT               = 72
-	GoLexerBYTE_VALUE             = 73
-	GoLexerOCTAL_BYTE_VALUE       = 74
-	GoLexerHEX_BYTE_VALUE         = 75
-	GoLexerLITTLE_U_VALUE         = 76
-	GoLexerBIG_U_VALUE            = 77
-	GoLexerRAW_STRING_LIT         = 78
-	GoLexerINTERPRETED_STRING_LIT = 79
-	GoLexerWS                     = 80
-	GoLexerCOMMENT                = 81
-	GoLexerTERMINATOR             = 82
-	GoLexerLINE_COMMENT           = 83
-	GoLexerWS_NLSEMI              = 84
-	GoLexerCOMMENT_NLSEMI         = 85
-	GoLexerLINE_COMMENT_NLSEMI    = 86
-	GoLexerEOS                    = 87
-	GoLexerOTHER                  = 88
+	GoLexerUNDERLYING             = 64
+	GoLexerDECIMAL_LIT            = 65
+	GoLexerBINARY_LIT             = 66
+	GoLexerOCTAL_LIT              = 67
+	GoLexerHEX_LIT                = 68
+	GoLexerFLOAT_LIT              = 69
+	GoLexerDECIMAL_FLOAT_LIT      = 70
+	GoLexerHEX_FLOAT_LIT          = 71
+	GoLexerIMAGINARY_LIT          = 72
+	GoLexerRUNE_LIT               = 73
+	GoLexerBYTE_VALUE             = 74
+	GoLexerOCTAL_BYTE_VALUE       = 75
+	GoLexerHEX_BYTE_VALUE         = 76
+	GoLexerLITTLE_U_VALUE         = 77
+	GoLexerBIG_U_VALUE            = 78
+	GoLexerRAW_STRING_LIT         = 79
+	GoLexerINTERPRETED_STRING_LIT = 80
+	GoLexerWS                     = 81
+	GoLexerCOMMENT                = 82
+	GoLexerTERMINATOR             = 83
+	GoLexerLINE_COMMENT           = 84
+	GoLexerWS_NLSEMI              = 85
+	GoLexerCOMMENT_NLSEMI         = 86
+	GoLexerLINE_COMMENT_NLSEMI    = 87
+	GoLexerEOS                    = 88
+	GoLexerOTHER                  = 89
 )
 
 // GoLexerNLSEMI is the GoLexer mode.
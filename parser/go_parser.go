@@ -40,7 +40,7 @@ func goparserParserInit() {
 		"", "'('", "')'", "'{'", "'}'", "'['", "']'", "'='", "','", "';'", "':'",
 		"'.'", "'++'", "'--'", "':='", "'...'", "'||'", "'&&'", "'=='", "'!='",
 		"'<'", "'<='", "'>'", "'>='", "'|'", "'/'", "'%'", "'<<'", "'>>'", "'&^'",
-		"'!'", "'+'", "'-'", "'^'", "'*'", "'&'", "'<-'",
+		"'!'", "'+'", "'-'", "'^'", "'*'", "'&'", "'<-'", "'~'",
 	}
 	staticData.symbolicNames = []string{
 		"", "BREAK", "DEFAULT", "FUNC", "INTERFACE", "SELECT", "CASE", "DEFER",
@@ -52,24 +52,26 @@ func goparserParserInit() {
 		"LOGICAL_AND", "EQUALS", "NOT_EQUALS", "LESS", "LESS_OR_EQUALS", "GREATER",
 		"GREATER_OR_EQUALS", "OR", "DIV", "MOD", "LSHIFT", "RSHIFT", "BIT_CLEAR",
 		"EXCLAMATION", "PLUS", "MINUS", "CARET", "STAR", "AMPERSAND", "RECEIVE",
-		"DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT", "DECIMAL_FLOAT_LIT",
-		"HEX_FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "BYTE_VALUE", "OCTAL_BYTE_VALUE",
-		"HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE", "RAW_STRING_LIT",
-		"INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR", "LINE_COMMENT",
-		"WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI", "EOS", "OTHER",
+		"UNDERLYING", "DECIMAL_LIT", "BINARY_LIT", "OCTAL_LIT", "HEX_LIT", "FLOAT_LIT",
+		"DECIMAL_FLOAT_LIT", "HEX_FLOAT_LIT", "IMAGINARY_LIT", "RUNE_LIT", "BYTE_VALUE",
+		"OCTAL_BYTE_VALUE", "HEX_BYTE_VALUE", "LITTLE_U_VALUE", "BIG_U_VALUE",
+		"RAW_STRING_LIT", "INTERPRETED_STRING_LIT", "WS", "COMMENT", "TERMINATOR",
+		"LINE_COMMENT", "WS_NLSEMI", "COMMENT_NLSEMI", "LINE_COMMENT_NLSEMI",
+		"EOS", "OTHER",
 	}
 	staticData.ruleNames = []string{
 		"sourceFile", "packageClause", "importDecl", "importSpec", "importPath",
 		"declaration", "constDecl", "constSpec", "identifierList", "expressionList",
-		"typeDecl", "typeSpec", "functionDecl", "methodDecl", "receiver", "varDecl",
-		"varSpec", "block", "statementList", "statement", "simpleStmt", "expressionStmt",
+		"typeDecl", "typeSpec", "typeParameters", "typeParameterDecl", "typeElement",
+		"typeTerm", "functionDecl", "methodDecl", "receiver", "varDecl", "varSpec",
+		"block", "statementList", "statement", "simpleStmt", "expressionStmt",
 		"sendStmt", "incDecStmt", "assignment", "assign_op", "shortVarDecl",
 		"emptyStmt", "labeledStmt", "returnStmt", "breakStmt", "continueStmt",
 		"gotoStmt", "fallthroughStmt", "deferStmt", "ifStmt", "switchStmt",
 		"exprSwitchStmt", "exprCaseClause", "exprSwitchCase", "typeSwitchStmt",
 		"typeSwitchGuard", "typeCaseClause", "typeSwitchCase", "typeList", "selectStmt",
 		"commClause", "commCase", "recvStmt", "forStmt", "forClause", "rangeClause",
-		"goStmt", "type_", "typeName", "typeLit", "arrayType", "arrayLength",
+		"goStmt", "type_", "typeArgs", "typeName", "typeLit", "arrayType", "arrayLength",
 		"elementType", "pointerType", "interfaceType", "sliceType", "mapType",
 		"channelType", "methodSpec", "functionType", "signature", "result",
 		"parameters", "parameterDecl", "expression", "primaryExpr", "conversion",
@@ -81,7 +83,7 @@ func goparserParserInit() {
 	}
 	staticData.predictionContextCache = antlr.NewPredictionContextCache()
 	staticData.serializedATN = []int32{
-		4, 1, 88, 962, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 1, 89, 1026, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
 		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
 		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
 		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
@@ -99,425 +101,456 @@ func goparserParserInit() {
 		2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7, 83, 2,
 		84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88, 2, 89,
 		7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2, 94, 7,
-		94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 1, 0, 1, 0,
-		1, 0, 1, 0, 1, 0, 5, 0, 204, 8, 0, 10, 0, 12, 0, 207, 9, 0, 1, 0, 1, 0,
-		1, 0, 3, 0, 212, 8, 0, 1, 0, 1, 0, 5, 0, 216, 8, 0, 10, 0, 12, 0, 219,
+		94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99, 7, 99,
+		2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103, 1, 0, 1,
+		0, 1, 0, 1, 0, 1, 0, 5, 0, 214, 8, 0, 10, 0, 12, 0, 217, 9, 0, 1, 0, 1,
+		0, 1, 0, 3, 0, 222, 8, 0, 1, 0, 1, 0, 5, 0, 226, 8, 0, 10, 0, 12, 0, 229,
 		9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2, 1, 2,
-		5, 2, 232, 8, 2, 10, 2, 12, 2, 235, 9, 2, 1, 2, 3, 2, 238, 8, 2, 1, 3,
-		3, 3, 241, 8, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 3, 5, 250, 8,
-		5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 258, 8, 6, 10, 6, 12, 6, 261,
-		9, 6, 1, 6, 3, 6, 264, 8, 6, 1, 7, 1, 7, 3, 7, 268, 8, 7, 1, 7, 1, 7, 3,
-		7, 272, 8, 7, 1, 8, 1, 8, 1, 8, 5, 8, 277, 8, 8, 10, 8, 12, 8, 280, 9,
-		8, 1, 9, 1, 9, 1, 9, 5, 9, 285, 8, 9, 10, 9, 12, 9, 288, 9, 9, 1, 10, 1,
-		10, 1, 10, 1, 10, 1, 10, 1, 10, 5, 10, 296, 8, 10, 10, 10, 12, 10, 299,
-		9, 10, 1, 10, 3, 10, 302, 8, 10, 1, 11, 1, 11, 3, 11, 306, 8, 11, 1, 11,
-		1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 3, 12, 314, 8, 12, 1, 13, 1, 13, 1,
-		13, 1, 13, 1, 13, 3, 13, 321, 8, 13, 1, 14, 1, 14, 1, 15, 1, 15, 1, 15,
-		1, 15, 1, 15, 1, 15, 5, 15, 331, 8, 15, 10, 15, 12, 15, 334, 9, 15, 1,
-		15, 3, 15, 337, 8, 15, 1, 16, 1, 16, 1, 16, 1, 16, 3, 16, 343, 8, 16, 1,
-		16, 1, 16, 3, 16, 347, 8, 16, 1, 17, 1, 17, 3, 17, 351, 8, 17, 1, 17, 1,
-		17, 1, 18, 3, 18, 356, 8, 18, 1, 18, 3, 18, 359, 8, 18, 1, 18, 3, 18, 362,
-		8, 18, 1, 18, 1, 18, 1, 18, 4, 18, 367, 8, 18, 11, 18, 12, 18, 368, 1,
-		19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19,
-		1, 19, 1, 19, 1, 19, 1, 19, 3, 19, 386, 8, 19, 1, 20, 1, 20, 1, 20, 1,
-		20, 1, 20, 3, 20, 393, 8, 20, 1, 21, 1, 21, 1, 22, 1, 22, 1, 22, 1, 22,
-		1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1, 25, 3, 25, 409, 8,
-		25, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 28, 1, 28,
-		1, 28, 3, 28, 422, 8, 28, 1, 29, 1, 29, 3, 29, 426, 8, 29, 1, 30, 1, 30,
-		3, 30, 430, 8, 30, 1, 31, 1, 31, 3, 31, 434, 8, 31, 1, 32, 1, 32, 1, 32,
-		1, 33, 1, 33, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
-		35, 1, 35, 1, 35, 1, 35, 3, 35, 453, 8, 35, 1, 35, 1, 35, 1, 35, 1, 35,
-		3, 35, 459, 8, 35, 3, 35, 461, 8, 35, 1, 36, 1, 36, 3, 36, 465, 8, 36,
-		1, 37, 1, 37, 3, 37, 469, 8, 37, 1, 37, 3, 37, 472, 8, 37, 1, 37, 1, 37,
-		3, 37, 476, 8, 37, 3, 37, 478, 8, 37, 1, 37, 1, 37, 5, 37, 482, 8, 37,
-		10, 37, 12, 37, 485, 9, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 3, 38, 492,
-		8, 38, 1, 39, 1, 39, 1, 39, 3, 39, 497, 8, 39, 1, 40, 1, 40, 1, 40, 1,
-		40, 1, 40, 1, 40, 1, 40, 1, 40, 1, 40, 3, 40, 508, 8, 40, 1, 40, 1, 40,
-		5, 40, 512, 8, 40, 10, 40, 12, 40, 515, 9, 40, 1, 40, 1, 40, 1, 41, 1,
-		41, 3, 41, 521, 8, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 41, 1, 42,
-		1, 42, 1, 42, 3, 42, 532, 8, 42, 1, 43, 1, 43, 1, 43, 3, 43, 537, 8, 43,
-		1, 44, 1, 44, 3, 44, 541, 8, 44, 1, 44, 1, 44, 1, 44, 3, 44, 546, 8, 44,
-		5, 44, 548, 8, 44, 10, 44, 12, 44, 551, 9, 44, 1, 45, 1, 45, 1, 45, 5,
-		45, 556, 8, 45, 10, 45, 12, 45, 559, 9, 45, 1, 45, 1, 45, 1, 46, 1, 46,
-		1, 46, 3, 46, 566, 8, 46, 1, 47, 1, 47, 1, 47, 3, 47, 571, 8, 47, 1, 47,
-		3, 47, 574, 8, 47, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 3, 48, 582,
-		8, 48, 1, 48, 1, 48, 1, 49, 1, 49, 3, 49, 588, 8, 49, 1, 49, 1, 49, 3,
-		49, 592, 8, 49, 3, 49, 594, 8, 49, 1, 49, 1, 49, 1, 50, 3, 50, 599, 8,
-		50, 1, 50, 1, 50, 3, 50, 603, 8, 50, 1, 50, 1, 50, 3, 50, 607, 8, 50, 1,
-		51, 1, 51, 1, 51, 1, 51, 1, 51, 1, 51, 3, 51, 615, 8, 51, 1, 51, 1, 51,
-		1, 51, 1, 52, 1, 52, 1, 52, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 3,
-		53, 629, 8, 53, 1, 54, 1, 54, 3, 54, 633, 8, 54, 1, 55, 1, 55, 1, 55, 1,
-		55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 643, 8, 55, 1, 56, 1, 56, 1, 56,
-		1, 56, 1, 56, 1, 57, 1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 59, 1, 60, 1,
-		60, 1, 60, 1, 60, 3, 60, 661, 8, 60, 1, 60, 1, 60, 5, 60, 665, 8, 60, 10,
-		60, 12, 60, 668, 9, 60, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1, 61, 1, 62,
-		1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 63, 1, 63, 1, 63, 1, 63, 1, 63, 3,
-		63, 687, 8, 63, 1, 63, 1, 63, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64, 1, 64,
-		3, 64, 697, 8, 64, 1, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1, 66, 1, 66, 3,
-		66, 706, 8, 66, 1, 67, 1, 67, 3, 67, 710, 8, 67, 1, 68, 1, 68, 1, 68, 1,
-		68, 5, 68, 716, 8, 68, 10, 68, 12, 68, 719, 9, 68, 1, 68, 3, 68, 722, 8,
-		68, 3, 68, 724, 8, 68, 1, 68, 1, 68, 1, 69, 3, 69, 729, 8, 69, 1, 69, 3,
-		69, 732, 8, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1, 70, 1, 70, 3, 70, 740, 8,
-		70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 1, 70,
-		1, 70, 1, 70, 1, 70, 1, 70, 1, 70, 5, 70, 757, 8, 70, 10, 70, 12, 70, 760,
-		9, 70, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 766, 8, 71, 1, 71, 1, 71, 1,
-		71, 1, 71, 1, 71, 1, 71, 1, 71, 3, 71, 775, 8, 71, 5, 71, 777, 8, 71, 10,
-		71, 12, 71, 780, 9, 71, 1, 72, 1, 72, 1, 72, 1, 72, 3, 72, 786, 8, 72,
-		1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 73, 1, 73, 3, 73, 795, 8, 73, 1,
-		74, 1, 74, 1, 74, 1, 74, 1, 74, 1, 74, 3, 74, 803, 8, 74, 1, 75, 1, 75,
-		1, 75, 3, 75, 808, 8, 75, 1, 76, 1, 76, 1, 76, 1, 76, 3, 76, 814, 8, 76,
-		1, 77, 1, 77, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1,
-		80, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81,
-		836, 8, 81, 1, 82, 1, 82, 1, 82, 3, 82, 841, 8, 82, 3, 82, 843, 8, 82,
-		1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 5, 83, 850, 8, 83, 10, 83, 12, 83, 853,
-		9, 83, 1, 84, 1, 84, 1, 84, 3, 84, 858, 8, 84, 1, 84, 1, 84, 1, 85, 1,
-		85, 3, 85, 864, 8, 85, 1, 86, 1, 86, 3, 86, 868, 8, 86, 1, 87, 1, 87, 1,
-		87, 1, 87, 1, 87, 5, 87, 875, 8, 87, 10, 87, 12, 87, 878, 9, 87, 1, 87,
-		1, 87, 1, 88, 1, 88, 1, 88, 1, 88, 3, 88, 886, 8, 88, 1, 88, 3, 88, 889,
-		8, 88, 1, 89, 1, 89, 1, 90, 3, 90, 894, 8, 90, 1, 90, 1, 90, 1, 91, 1,
-		91, 1, 91, 1, 91, 1, 92, 1, 92, 1, 92, 1, 92, 1, 93, 1, 93, 3, 93, 908,
-		8, 93, 1, 93, 1, 93, 3, 93, 912, 8, 93, 1, 93, 3, 93, 915, 8, 93, 1, 93,
-		1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 922, 8, 93, 1, 93, 1, 93, 1, 94, 1,
-		94, 1, 94, 1, 94, 1, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 3, 95, 936,
-		8, 95, 3, 95, 938, 8, 95, 1, 95, 3, 95, 941, 8, 95, 1, 95, 3, 95, 944,
-		8, 95, 3, 95, 946, 8, 95, 1, 95, 1, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1,
-		97, 1, 97, 1, 98, 1, 98, 1, 98, 1, 98, 3, 98, 960, 8, 98, 1, 98, 0, 2,
-		140, 142, 99, 0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30,
-		32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66,
-		68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102,
-		104, 106, 108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132,
-		134, 136, 138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162,
-		164, 166, 168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192,
-		194, 196, 0, 10, 2, 0, 27, 27, 38, 38, 1, 0, 39, 40, 2, 0, 51, 56, 58,
-		62, 2, 0, 36, 36, 87, 87, 1, 0, 57, 63, 2, 0, 52, 56, 61, 62, 2, 0, 51,
-		51, 58, 60, 1, 0, 45, 50, 2, 0, 64, 67, 71, 72, 1, 0, 78, 79, 1022, 0,
-		198, 1, 0, 0, 0, 2, 222, 1, 0, 0, 0, 4, 225, 1, 0, 0, 0, 6, 240, 1, 0,
-		0, 0, 8, 244, 1, 0, 0, 0, 10, 249, 1, 0, 0, 0, 12, 251, 1, 0, 0, 0, 14,
-		265, 1, 0, 0, 0, 16, 273, 1, 0, 0, 0, 18, 281, 1, 0, 0, 0, 20, 289, 1,
-		0, 0, 0, 22, 303, 1, 0, 0, 0, 24, 309, 1, 0, 0, 0, 26, 315, 1, 0, 0, 0,
-		28, 322, 1, 0, 0, 0, 30, 324, 1, 0, 0, 0, 32, 338, 1, 0, 0, 0, 34, 348,
-		1, 0, 0, 0, 36, 366, 1, 0, 0, 0, 38, 385, 1, 0, 0, 0, 40, 392, 1, 0, 0,
-		0, 42, 394, 1, 0, 0, 0, 44, 396, 1, 0, 0, 0, 46, 400, 1, 0, 0, 0, 48, 403,
-		1, 0, 0, 0, 50, 408, 1, 0, 0, 0, 52, 412, 1, 0, 0, 0, 54, 416, 1, 0, 0,
-		0, 56, 418, 1, 0, 0, 0, 58, 423, 1, 0, 0, 0, 60, 427, 1, 0, 0, 0, 62, 431,
-		1, 0, 0, 0, 64, 435, 1, 0, 0, 0, 66, 438, 1, 0, 0, 0, 68, 440, 1, 0, 0,
-		0, 70, 443, 1, 0, 0, 0, 72, 464, 1, 0, 0, 0, 74, 466, 1, 0, 0, 0, 76, 488,
-		1, 0, 0, 0, 78, 496, 1, 0, 0, 0, 80, 498, 1, 0, 0, 0, 82, 520, 1, 0, 0,
-		0, 84, 528, 1, 0, 0, 0, 86, 536, 1, 0, 0, 0, 88, 540, 1, 0, 0, 0, 90, 552,
-		1, 0, 0, 0, 92, 562, 1, 0, 0, 0, 94, 573, 1, 0, 0, 0, 96, 581, 1, 0, 0,
-		0, 98, 585, 1, 0, 0, 0, 100, 598, 1, 0, 0, 0, 102, 614, 1, 0, 0, 0, 104,
-		619, 1, 0, 0, 0, 106, 628, 1, 0, 0, 0, 108, 632, 1, 0, 0, 0, 110, 642,
-		1, 0, 0, 0, 112, 644, 1, 0, 0, 0, 114, 649, 1, 0, 0, 0, 116, 651, 1, 0,
-		0, 0, 118, 653, 1, 0, 0, 0, 120, 656, 1, 0, 0, 0, 122, 671, 1, 0, 0, 0,
-		124, 675, 1, 0, 0, 0, 126, 686, 1, 0, 0, 0, 128, 696, 1, 0, 0, 0, 130,
-		698, 1, 0, 0, 0, 132, 705, 1, 0, 0, 0, 134, 709, 1, 0, 0, 0, 136, 711,
-		1, 0, 0, 0, 138, 728, 1, 0, 0, 0, 140, 739, 1, 0, 0, 0, 142, 765, 1, 0,
-		0, 0, 144, 781, 1, 0, 0, 0, 146, 794, 1, 0, 0, 0, 148, 802, 1, 0, 0, 0,
-		150, 807, 1, 0, 0, 0, 152, 813, 1, 0, 0, 0, 154, 815, 1, 0, 0, 0, 156,
-		817, 1, 0, 0, 0, 158, 819, 1, 0, 0, 0, 160, 823, 1, 0, 0, 0, 162, 835,
-		1, 0, 0, 0, 164, 837, 1, 0, 0, 0, 166, 846, 1, 0, 0, 0, 168, 857, 1, 0,
-		0, 0, 170, 863, 1, 0, 0, 0, 172, 867, 1, 0, 0, 0, 174, 869, 1, 0, 0, 0,
-		176, 885, 1, 0, 0, 0, 178, 890, 1, 0, 0, 0, 180, 893, 1, 0, 0, 0, 182,
-		897, 1, 0, 0, 0, 184, 901, 1, 0, 0, 0, 186, 905, 1, 0, 0, 0, 188, 925,
-		1, 0, 0, 0, 190, 930, 1, 0, 0, 0, 192, 949, 1, 0, 0, 0, 194, 953, 1, 0,
-		0, 0, 196, 959, 1, 0, 0, 0, 198, 199, 3, 2, 1, 0, 199, 205, 3, 196, 98,
-		0, 200, 201, 3, 4, 2, 0, 201, 202, 3, 196, 98, 0, 202, 204, 1, 0, 0, 0,
-		203, 200, 1, 0, 0, 0, 204, 207, 1, 0, 0, 0, 205, 203, 1, 0, 0, 0, 205,
-		206, 1, 0, 0, 0, 206, 217, 1, 0, 0, 0, 207, 205, 1, 0, 0, 0, 208, 212,
-		3, 24, 12, 0, 209, 212, 3, 26, 13, 0, 210, 212, 3, 10, 5, 0, 211, 208,
-		1, 0, 0, 0, 211, 209, 1, 0, 0, 0, 211, 210, 1, 0, 0, 0, 212, 213, 1, 0,
-		0, 0, 213, 214, 3, 196, 98, 0, 214, 216, 1, 0, 0, 0, 215, 211, 1, 0, 0,
-		0, 216, 219, 1, 0, 0, 0, 217, 215, 1, 0, 0, 0, 217, 218, 1, 0, 0, 0, 218,
-		220, 1, 0, 0, 0, 219, 217, 1, 0, 0, 0, 220, 221, 5, 0, 0, 1, 221, 1, 1,
-		0, 0, 0, 222, 223, 5, 14, 0, 0, 223, 224, 5, 27, 0, 0, 224, 3, 1, 0, 0,
-		0, 225, 237, 5, 23, 0, 0, 226, 238, 3, 6, 3, 0, 227, 233, 5, 28, 0, 0,
-		228, 229, 3, 6, 3, 0, 229, 230, 3, 196, 98, 0, 230, 232, 1, 0, 0, 0, 231,
-		228, 1, 0, 0, 0, 232, 235, 1, 0, 0, 0, 233, 231, 1, 0, 0, 0, 233, 234,
-		1, 0, 0, 0, 234, 236, 1, 0, 0, 0, 235, 233, 1, 0, 0, 0, 236, 238, 5, 29,
-		0, 0, 237, 226, 1, 0, 0, 0, 237, 227, 1, 0, 0, 0, 238, 5, 1, 0, 0, 0, 239,
-		241, 7, 0, 0, 0, 240, 239, 1, 0, 0, 0, 240, 241, 1, 0, 0, 0, 241, 242,
-		1, 0, 0, 0, 242, 243, 3, 8, 4, 0, 243, 7, 1, 0, 0, 0, 244, 245, 3, 178,
-		89, 0, 245, 9, 1, 0, 0, 0, 246, 250, 3, 12, 6, 0, 247, 250, 3, 20, 10,
-		0, 248, 250, 3, 30, 15, 0, 249, 246, 1, 0, 0, 0, 249, 247, 1, 0, 0, 0,
-		249, 248, 1, 0, 0, 0, 250, 11, 1, 0, 0, 0, 251, 263, 5, 16, 0, 0, 252,
-		264, 3, 14, 7, 0, 253, 259, 5, 28, 0, 0, 254, 255, 3, 14, 7, 0, 255, 256,
-		3, 196, 98, 0, 256, 258, 1, 0, 0, 0, 257, 254, 1, 0, 0, 0, 258, 261, 1,
-		0, 0, 0, 259, 257, 1, 0, 0, 0, 259, 260, 1, 0, 0, 0, 260, 262, 1, 0, 0,
-		0, 261, 259, 1, 0, 0, 0, 262, 264, 5, 29, 0, 0, 263, 252, 1, 0, 0, 0, 263,
-		253, 1, 0, 0, 0, 264, 13, 1, 0, 0, 0, 265, 271, 3, 16, 8, 0, 266, 268,
-		3, 106, 53, 0, 267, 266, 1, 0, 0, 0, 267, 268, 1, 0, 0, 0, 268, 269, 1,
-		0, 0, 0, 269, 270, 5, 34, 0, 0, 270, 272, 3, 18, 9, 0, 271, 267, 1, 0,
-		0, 0, 271, 272, 1, 0, 0, 0, 272, 15, 1, 0, 0, 0, 273, 278, 5, 27, 0, 0,
-		274, 275, 5, 35, 0, 0, 275, 277, 5, 27, 0, 0, 276, 274, 1, 0, 0, 0, 277,
-		280, 1, 0, 0, 0, 278, 276, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 17, 1,
-		0, 0, 0, 280, 278, 1, 0, 0, 0, 281, 286, 3, 140, 70, 0, 282, 283, 5, 35,
-		0, 0, 283, 285, 3, 140, 70, 0, 284, 282, 1, 0, 0, 0, 285, 288, 1, 0, 0,
-		0, 286, 284, 1, 0, 0, 0, 286, 287, 1, 0, 0, 0, 287, 19, 1, 0, 0, 0, 288,
-		286, 1, 0, 0, 0, 289, 301, 5, 20, 0, 0, 290, 302, 3, 22, 11, 0, 291, 297,
-		5, 28, 0, 0, 292, 293, 3, 22, 11, 0, 293, 294, 3, 196, 98, 0, 294, 296,
-		1, 0, 0, 0, 295, 292, 1, 0, 0, 0, 296, 299, 1, 0, 0, 0, 297, 295, 1, 0,
-		0, 0, 297, 298, 1, 0, 0, 0, 298, 300, 1, 0, 0, 0, 299, 297, 1, 0, 0, 0,
-		300, 302, 5, 29, 0, 0, 301, 290, 1, 0, 0, 0, 301, 291, 1, 0, 0, 0, 302,
-		21, 1, 0, 0, 0, 303, 305, 5, 27, 0, 0, 304, 306, 5, 34, 0, 0, 305, 304,
-		1, 0, 0, 0, 305, 306, 1, 0, 0, 0, 306, 307, 1, 0, 0, 0, 307, 308, 3, 106,
-		53, 0, 308, 23, 1, 0, 0, 0, 309, 310, 5, 3, 0, 0, 310, 311, 5, 27, 0, 0,
-		311, 313, 3, 132, 66, 0, 312, 314, 3, 34, 17, 0, 313, 312, 1, 0, 0, 0,
-		313, 314, 1, 0, 0, 0, 314, 25, 1, 0, 0, 0, 315, 316, 5, 3, 0, 0, 316, 317,
-		3, 28, 14, 0, 317, 318, 5, 27, 0, 0, 318, 320, 3, 132, 66, 0, 319, 321,
-		3, 34, 17, 0, 320, 319, 1, 0, 0, 0, 320, 321, 1, 0, 0, 0, 321, 27, 1, 0,
-		0, 0, 322, 323, 3, 136, 68, 0, 323, 29, 1, 0, 0, 0, 324, 336, 5, 25, 0,
-		0, 325, 337, 3, 32, 16, 0, 326, 332, 5, 28, 0, 0, 327, 328, 3, 32, 16,
-		0, 328, 329, 3, 196, 98, 0, 329, 331, 1, 0, 0, 0, 330, 327, 1, 0, 0, 0,
-		331, 334, 1, 0, 0, 0, 332, 330, 1, 0, 0, 0, 332, 333, 1, 0, 0, 0, 333,
-		335, 1, 0, 0, 0, 334, 332, 1, 0, 0, 0, 335, 337, 5, 29, 0, 0, 336, 325,
-		1, 0, 0, 0, 336, 326, 1, 0, 0, 0, 337, 31, 1, 0, 0, 0, 338, 346, 3, 16,
-		8, 0, 339, 342, 3, 106, 53, 0, 340, 341, 5, 34, 0, 0, 341, 343, 3, 18,
-		9, 0, 342, 340, 1, 0, 0, 0, 342, 343, 1, 0, 0, 0, 343, 347, 1, 0, 0, 0,
-		344, 345, 5, 34, 0, 0, 345, 347, 3, 18, 9, 0, 346, 339, 1, 0, 0, 0, 346,
-		344, 1, 0, 0, 0, 347, 33, 1, 0, 0, 0, 348, 350, 5, 30, 0, 0, 349, 351,
-		3, 36, 18, 0, 350, 349, 1, 0, 0, 0, 350, 351, 1, 0, 0, 0, 351, 352, 1,
-		0, 0, 0, 352, 353, 5, 31, 0, 0, 353, 35, 1, 0, 0, 0, 354, 356, 5, 36, 0,
-		0, 355, 354, 1, 0, 0, 0, 355, 356, 1, 0, 0, 0, 356, 362, 1, 0, 0, 0, 357,
-		359, 5, 87, 0, 0, 358, 357, 1, 0, 0, 0, 358, 359, 1, 0, 0, 0, 359, 362,
-		1, 0, 0, 0, 360, 362, 4, 18, 0, 0, 361, 355, 1, 0, 0, 0, 361, 358, 1, 0,
-		0, 0, 361, 360, 1, 0, 0, 0, 362, 363, 1, 0, 0, 0, 363, 364, 3, 38, 19,
-		0, 364, 365, 3, 196, 98, 0, 365, 367, 1, 0, 0, 0, 366, 361, 1, 0, 0, 0,
-		367, 368, 1, 0, 0, 0, 368, 366, 1, 0, 0, 0, 368, 369, 1, 0, 0, 0, 369,
-		37, 1, 0, 0, 0, 370, 386, 3, 10, 5, 0, 371, 386, 3, 56, 28, 0, 372, 386,
-		3, 40, 20, 0, 373, 386, 3, 104, 52, 0, 374, 386, 3, 58, 29, 0, 375, 386,
-		3, 60, 30, 0, 376, 386, 3, 62, 31, 0, 377, 386, 3, 64, 32, 0, 378, 386,
-		3, 66, 33, 0, 379, 386, 3, 34, 17, 0, 380, 386, 3, 70, 35, 0, 381, 386,
-		3, 72, 36, 0, 382, 386, 3, 90, 45, 0, 383, 386, 3, 98, 49, 0, 384, 386,
-		3, 68, 34, 0, 385, 370, 1, 0, 0, 0, 385, 371, 1, 0, 0, 0, 385, 372, 1,
-		0, 0, 0, 385, 373, 1, 0, 0, 0, 385, 374, 1, 0, 0, 0, 385, 375, 1, 0, 0,
-		0, 385, 376, 1, 0, 0, 0, 385, 377, 1, 0, 0, 0, 385, 378, 1, 0, 0, 0, 385,
-		379, 1, 0, 0, 0, 385, 380, 1, 0, 0, 0, 385, 381, 1, 0, 0, 0, 385, 382,
-		1, 0, 0, 0, 385, 383, 1, 0, 0, 0, 385, 384, 1, 0, 0, 0, 386, 39, 1, 0,
-		0, 0, 387, 393, 3, 44, 22, 0, 388, 393, 3, 46, 23, 0, 389, 393, 3, 48,
-		24, 0, 390, 393, 3, 42, 21, 0, 391, 393, 3, 52, 26, 0, 392, 387, 1, 0,
-		0, 0, 392, 388, 1, 0, 0, 0, 392, 389, 1, 0, 0, 0, 392, 390, 1, 0, 0, 0,
-		392, 391, 1, 0, 0, 0, 393, 41, 1, 0, 0, 0, 394, 395, 3, 140, 70, 0, 395,
-		43, 1, 0, 0, 0, 396, 397, 3, 140, 70, 0, 397, 398, 5, 63, 0, 0, 398, 399,
-		3, 140, 70, 0, 399, 45, 1, 0, 0, 0, 400, 401, 3, 140, 70, 0, 401, 402,
-		7, 1, 0, 0, 402, 47, 1, 0, 0, 0, 403, 404, 3, 18, 9, 0, 404, 405, 3, 50,
-		25, 0, 405, 406, 3, 18, 9, 0, 406, 49, 1, 0, 0, 0, 407, 409, 7, 2, 0, 0,
-		408, 407, 1, 0, 0, 0, 408, 409, 1, 0, 0, 0, 409, 410, 1, 0, 0, 0, 410,
-		411, 5, 34, 0, 0, 411, 51, 1, 0, 0, 0, 412, 413, 3, 16, 8, 0, 413, 414,
-		5, 41, 0, 0, 414, 415, 3, 18, 9, 0, 415, 53, 1, 0, 0, 0, 416, 417, 7, 3,
-		0, 0, 417, 55, 1, 0, 0, 0, 418, 419, 5, 27, 0, 0, 419, 421, 5, 37, 0, 0,
-		420, 422, 3, 38, 19, 0, 421, 420, 1, 0, 0, 0, 421, 422, 1, 0, 0, 0, 422,
-		57, 1, 0, 0, 0, 423, 425, 5, 24, 0, 0, 424, 426, 3, 18, 9, 0, 425, 424,
-		1, 0, 0, 0, 425, 426, 1, 0, 0, 0, 426, 59, 1, 0, 0, 0, 427, 429, 5, 1,
-		0, 0, 428, 430, 5, 27, 0, 0, 429, 428, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0,
-		430, 61, 1, 0, 0, 0, 431, 433, 5, 21, 0, 0, 432, 434, 5, 27, 0, 0, 433,
-		432, 1, 0, 0, 0, 433, 434, 1, 0, 0, 0, 434, 63, 1, 0, 0, 0, 435, 436, 5,
-		13, 0, 0, 436, 437, 5, 27, 0, 0, 437, 65, 1, 0, 0, 0, 438, 439, 5, 17,
-		0, 0, 439, 67, 1, 0, 0, 0, 440, 441, 5, 7, 0, 0, 441, 442, 3, 140, 70,
-		0, 442, 69, 1, 0, 0, 0, 443, 452, 5, 18, 0, 0, 444, 453, 3, 140, 70, 0,
-		445, 446, 3, 196, 98, 0, 446, 447, 3, 140, 70, 0, 447, 453, 1, 0, 0, 0,
-		448, 449, 3, 40, 20, 0, 449, 450, 3, 196, 98, 0, 450, 451, 3, 140, 70,
-		0, 451, 453, 1, 0, 0, 0, 452, 444, 1, 0, 0, 0, 452, 445, 1, 0, 0, 0, 452,
-		448, 1, 0, 0, 0, 453, 454, 1, 0, 0, 0, 454, 460, 3, 34, 17, 0, 455, 458,
-		5, 12, 0, 0, 456, 459, 3, 70, 35, 0, 457, 459, 3, 34, 17, 0, 458, 456,
-		1, 0, 0, 0, 458, 457, 1, 0, 0, 0, 459, 461, 1, 0, 0, 0, 460, 455, 1, 0,
-		0, 0, 460, 461, 1, 0, 0, 0, 461, 71, 1, 0, 0, 0, 462, 465, 3, 74, 37, 0,
-		463, 465, 3, 80, 40, 0, 464, 462, 1, 0, 0, 0, 464, 463, 1, 0, 0, 0, 465,
-		73, 1, 0, 0, 0, 466, 477, 5, 15, 0, 0, 467, 469, 3, 140, 70, 0, 468, 467,
-		1, 0, 0, 0, 468, 469, 1, 0, 0, 0, 469, 478, 1, 0, 0, 0, 470, 472, 3, 40,
-		20, 0, 471, 470, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472, 473, 1, 0, 0, 0,
-		473, 475, 3, 196, 98, 0, 474, 476, 3, 140, 70, 0, 475, 474, 1, 0, 0, 0,
-		475, 476, 1, 0, 0, 0, 476, 478, 1, 0, 0, 0, 477, 468, 1, 0, 0, 0, 477,
-		471, 1, 0, 0, 0, 478, 479, 1, 0, 0, 0, 479, 483, 5, 30, 0, 0, 480, 482,
-		3, 76, 38, 0, 481, 480, 1, 0, 0, 0, 482, 485, 1, 0, 0, 0, 483, 481, 1,
-		0, 0, 0, 483, 484, 1, 0, 0, 0, 484, 486, 1, 0, 0, 0, 485, 483, 1, 0, 0,
-		0, 486, 487, 5, 31, 0, 0, 487, 75, 1, 0, 0, 0, 488, 489, 3, 78, 39, 0,
-		489, 491, 5, 37, 0, 0, 490, 492, 3, 36, 18, 0, 491, 490, 1, 0, 0, 0, 491,
-		492, 1, 0, 0, 0, 492, 77, 1, 0, 0, 0, 493, 494, 5, 6, 0, 0, 494, 497, 3,
-		18, 9, 0, 495, 497, 5, 2, 0, 0, 496, 493, 1, 0, 0, 0, 496, 495, 1, 0, 0,
-		0, 497, 79, 1, 0, 0, 0, 498, 507, 5, 15, 0, 0, 499, 508, 3, 82, 41, 0,
-		500, 501, 3, 196, 98, 0, 501, 502, 3, 82, 41, 0, 502, 508, 1, 0, 0, 0,
-		503, 504, 3, 40, 20, 0, 504, 505, 3, 196, 98, 0, 505, 506, 3, 82, 41, 0,
-		506, 508, 1, 0, 0, 0, 507, 499, 1, 0, 0, 0, 507, 500, 1, 0, 0, 0, 507,
-		503, 1, 0, 0, 0, 508, 509, 1, 0, 0, 0, 509, 513, 5, 30, 0, 0, 510, 512,
-		3, 84, 42, 0, 511, 510, 1, 0, 0, 0, 512, 515, 1, 0, 0, 0, 513, 511, 1,
-		0, 0, 0, 513, 514, 1, 0, 0, 0, 514, 516, 1, 0, 0, 0, 515, 513, 1, 0, 0,
-		0, 516, 517, 5, 31, 0, 0, 517, 81, 1, 0, 0, 0, 518, 519, 5, 27, 0, 0, 519,
-		521, 5, 41, 0, 0, 520, 518, 1, 0, 0, 0, 520, 521, 1, 0, 0, 0, 521, 522,
-		1, 0, 0, 0, 522, 523, 3, 142, 71, 0, 523, 524, 5, 38, 0, 0, 524, 525, 5,
-		28, 0, 0, 525, 526, 5, 20, 0, 0, 526, 527, 5, 29, 0, 0, 527, 83, 1, 0,
-		0, 0, 528, 529, 3, 86, 43, 0, 529, 531, 5, 37, 0, 0, 530, 532, 3, 36, 18,
-		0, 531, 530, 1, 0, 0, 0, 531, 532, 1, 0, 0, 0, 532, 85, 1, 0, 0, 0, 533,
-		534, 5, 6, 0, 0, 534, 537, 3, 88, 44, 0, 535, 537, 5, 2, 0, 0, 536, 533,
-		1, 0, 0, 0, 536, 535, 1, 0, 0, 0, 537, 87, 1, 0, 0, 0, 538, 541, 3, 106,
-		53, 0, 539, 541, 5, 26, 0, 0, 540, 538, 1, 0, 0, 0, 540, 539, 1, 0, 0,
-		0, 541, 549, 1, 0, 0, 0, 542, 545, 5, 35, 0, 0, 543, 546, 3, 106, 53, 0,
-		544, 546, 5, 26, 0, 0, 545, 543, 1, 0, 0, 0, 545, 544, 1, 0, 0, 0, 546,
-		548, 1, 0, 0, 0, 547, 542, 1, 0, 0, 0, 548, 551, 1, 0, 0, 0, 549, 547,
-		1, 0, 0, 0, 549, 550, 1, 0, 0, 0, 550, 89, 1, 0, 0, 0, 551, 549, 1, 0,
-		0, 0, 552, 553, 5, 5, 0, 0, 553, 557, 5, 30, 0, 0, 554, 556, 3, 92, 46,
-		0, 555, 554, 1, 0, 0, 0, 556, 559, 1, 0, 0, 0, 557, 555, 1, 0, 0, 0, 557,
-		558, 1, 0, 0, 0, 558, 560, 1, 0, 0, 0, 559, 557, 1, 0, 0, 0, 560, 561,
-		5, 31, 0, 0, 561, 91, 1, 0, 0, 0, 562, 563, 3, 94, 47, 0, 563, 565, 5,
-		37, 0, 0, 564, 566, 3, 36, 18, 0, 565, 564, 1, 0, 0, 0, 565, 566, 1, 0,
-		0, 0, 566, 93, 1, 0, 0, 0, 567, 570, 5, 6, 0, 0, 568, 571, 3, 44, 22, 0,
-		569, 571, 3, 96, 48, 0, 570, 568, 1, 0, 0, 0, 570, 569, 1, 0, 0, 0, 571,
-		574, 1, 0, 0, 0, 572, 574, 5, 2, 0, 0, 573, 567, 1, 0, 0, 0, 573, 572,
-		1, 0, 0, 0, 574, 95, 1, 0, 0, 0, 575, 576, 3, 18, 9, 0, 576, 577, 5, 34,
-		0, 0, 577, 582, 1, 0, 0, 0, 578, 579, 3, 16, 8, 0, 579, 580, 5, 41, 0,
-		0, 580, 582, 1, 0, 0, 0, 581, 575, 1, 0, 0, 0, 581, 578, 1, 0, 0, 0, 581,
-		582, 1, 0, 0, 0, 582, 583, 1, 0, 0, 0, 583, 584, 3, 140, 70, 0, 584, 97,
-		1, 0, 0, 0, 585, 593, 5, 22, 0, 0, 586, 588, 3, 140, 70, 0, 587, 586, 1,
-		0, 0, 0, 587, 588, 1, 0, 0, 0, 588, 594, 1, 0, 0, 0, 589, 594, 3, 100,
-		50, 0, 590, 592, 3, 102, 51, 0, 591, 590, 1, 0, 0, 0, 591, 592, 1, 0, 0,
-		0, 592, 594, 1, 0, 0, 0, 593, 587, 1, 0, 0, 0, 593, 589, 1, 0, 0, 0, 593,
-		591, 1, 0, 0, 0, 594, 595, 1, 0, 0, 0, 595, 596, 3, 34, 17, 0, 596, 99,
-		1, 0, 0, 0, 597, 599, 3, 40, 20, 0, 598, 597, 1, 0, 0, 0, 598, 599, 1,
-		0, 0, 0, 599, 600, 1, 0, 0, 0, 600, 602, 3, 196, 98, 0, 601, 603, 3, 140,
-		70, 0, 602, 601, 1, 0, 0, 0, 602, 603, 1, 0, 0, 0, 603, 604, 1, 0, 0, 0,
-		604, 606, 3, 196, 98, 0, 605, 607, 3, 40, 20, 0, 606, 605, 1, 0, 0, 0,
-		606, 607, 1, 0, 0, 0, 607, 101, 1, 0, 0, 0, 608, 609, 3, 18, 9, 0, 609,
-		610, 5, 34, 0, 0, 610, 615, 1, 0, 0, 0, 611, 612, 3, 16, 8, 0, 612, 613,
-		5, 41, 0, 0, 613, 615, 1, 0, 0, 0, 614, 608, 1, 0, 0, 0, 614, 611, 1, 0,
-		0, 0, 614, 615, 1, 0, 0, 0, 615, 616, 1, 0, 0, 0, 616, 617, 5, 19, 0, 0,
-		617, 618, 3, 140, 70, 0, 618, 103, 1, 0, 0, 0, 619, 620, 5, 8, 0, 0, 620,
-		621, 3, 140, 70, 0, 621, 105, 1, 0, 0, 0, 622, 629, 3, 108, 54, 0, 623,
-		629, 3, 110, 55, 0, 624, 625, 5, 28, 0, 0, 625, 626, 3, 106, 53, 0, 626,
-		627, 5, 29, 0, 0, 627, 629, 1, 0, 0, 0, 628, 622, 1, 0, 0, 0, 628, 623,
-		1, 0, 0, 0, 628, 624, 1, 0, 0, 0, 629, 107, 1, 0, 0, 0, 630, 633, 3, 158,
-		79, 0, 631, 633, 5, 27, 0, 0, 632, 630, 1, 0, 0, 0, 632, 631, 1, 0, 0,
-		0, 633, 109, 1, 0, 0, 0, 634, 643, 3, 112, 56, 0, 635, 643, 3, 174, 87,
-		0, 636, 643, 3, 118, 59, 0, 637, 643, 3, 130, 65, 0, 638, 643, 3, 120,
-		60, 0, 639, 643, 3, 122, 61, 0, 640, 643, 3, 124, 62, 0, 641, 643, 3, 126,
-		63, 0, 642, 634, 1, 0, 0, 0, 642, 635, 1, 0, 0, 0, 642, 636, 1, 0, 0, 0,
-		642, 637, 1, 0, 0, 0, 642, 638, 1, 0, 0, 0, 642, 639, 1, 0, 0, 0, 642,
-		640, 1, 0, 0, 0, 642, 641, 1, 0, 0, 0, 643, 111, 1, 0, 0, 0, 644, 645,
-		5, 32, 0, 0, 645, 646, 3, 114, 57, 0, 646, 647, 5, 33, 0, 0, 647, 648,
-		3, 116, 58, 0, 648, 113, 1, 0, 0, 0, 649, 650, 3, 140, 70, 0, 650, 115,
-		1, 0, 0, 0, 651, 652, 3, 106, 53, 0, 652, 117, 1, 0, 0, 0, 653, 654, 5,
-		61, 0, 0, 654, 655, 3, 106, 53, 0, 655, 119, 1, 0, 0, 0, 656, 657, 5, 4,
-		0, 0, 657, 666, 5, 30, 0, 0, 658, 661, 3, 128, 64, 0, 659, 661, 3, 108,
-		54, 0, 660, 658, 1, 0, 0, 0, 660, 659, 1, 0, 0, 0, 661, 662, 1, 0, 0, 0,
-		662, 663, 3, 196, 98, 0, 663, 665, 1, 0, 0, 0, 664, 660, 1, 0, 0, 0, 665,
-		668, 1, 0, 0, 0, 666, 664, 1, 0, 0, 0, 666, 667, 1, 0, 0, 0, 667, 669,
-		1, 0, 0, 0, 668, 666, 1, 0, 0, 0, 669, 670, 5, 31, 0, 0, 670, 121, 1, 0,
-		0, 0, 671, 672, 5, 32, 0, 0, 672, 673, 5, 33, 0, 0, 673, 674, 3, 116, 58,
-		0, 674, 123, 1, 0, 0, 0, 675, 676, 5, 9, 0, 0, 676, 677, 5, 32, 0, 0, 677,
-		678, 3, 106, 53, 0, 678, 679, 5, 33, 0, 0, 679, 680, 3, 116, 58, 0, 680,
-		125, 1, 0, 0, 0, 681, 687, 5, 11, 0, 0, 682, 683, 5, 11, 0, 0, 683, 687,
-		5, 63, 0, 0, 684, 685, 5, 63, 0, 0, 685, 687, 5, 11, 0, 0, 686, 681, 1,
-		0, 0, 0, 686, 682, 1, 0, 0, 0, 686, 684, 1, 0, 0, 0, 687, 688, 1, 0, 0,
-		0, 688, 689, 3, 116, 58, 0, 689, 127, 1, 0, 0, 0, 690, 691, 5, 27, 0, 0,
-		691, 692, 3, 136, 68, 0, 692, 693, 3, 134, 67, 0, 693, 697, 1, 0, 0, 0,
-		694, 695, 5, 27, 0, 0, 695, 697, 3, 136, 68, 0, 696, 690, 1, 0, 0, 0, 696,
-		694, 1, 0, 0, 0, 697, 129, 1, 0, 0, 0, 698, 699, 5, 3, 0, 0, 699, 700,
-		3, 132, 66, 0, 700, 131, 1, 0, 0, 0, 701, 702, 3, 136, 68, 0, 702, 703,
-		3, 134, 67, 0, 703, 706, 1, 0, 0, 0, 704, 706, 3, 136, 68, 0, 705, 701,
-		1, 0, 0, 0, 705, 704, 1, 0, 0, 0, 706, 133, 1, 0, 0, 0, 707, 710, 3, 136,
-		68, 0, 708, 710, 3, 106, 53, 0, 709, 707, 1, 0, 0, 0, 709, 708, 1, 0, 0,
-		0, 710, 135, 1, 0, 0, 0, 711, 723, 5, 28, 0, 0, 712, 717, 3, 138, 69, 0,
-		713, 714, 5, 35, 0, 0, 714, 716, 3, 138, 69, 0, 715, 713, 1, 0, 0, 0, 716,
-		719, 1, 0, 0, 0, 717, 715, 1, 0, 0, 0, 717, 718, 1, 0, 0, 0, 718, 721,
-		1, 0, 0, 0, 719, 717, 1, 0, 0, 0, 720, 722, 5, 35, 0, 0, 721, 720, 1, 0,
-		0, 0, 721, 722, 1, 0, 0, 0, 722, 724, 1, 0, 0, 0, 723, 712, 1, 0, 0, 0,
-		723, 724, 1, 0, 0, 0, 724, 725, 1, 0, 0, 0, 725, 726, 5, 29, 0, 0, 726,
-		137, 1, 0, 0, 0, 727, 729, 3, 16, 8, 0, 728, 727, 1, 0, 0, 0, 728, 729,
-		1, 0, 0, 0, 729, 731, 1, 0, 0, 0, 730, 732, 5, 42, 0, 0, 731, 730, 1, 0,
-		0, 0, 731, 732, 1, 0, 0, 0, 732, 733, 1, 0, 0, 0, 733, 734, 3, 106, 53,
-		0, 734, 139, 1, 0, 0, 0, 735, 736, 6, 70, -1, 0, 736, 740, 3, 142, 71,
-		0, 737, 738, 7, 4, 0, 0, 738, 740, 3, 140, 70, 6, 739, 735, 1, 0, 0, 0,
-		739, 737, 1, 0, 0, 0, 740, 758, 1, 0, 0, 0, 741, 742, 10, 5, 0, 0, 742,
-		743, 7, 5, 0, 0, 743, 757, 3, 140, 70, 6, 744, 745, 10, 4, 0, 0, 745, 746,
-		7, 6, 0, 0, 746, 757, 3, 140, 70, 5, 747, 748, 10, 3, 0, 0, 748, 749, 7,
-		7, 0, 0, 749, 757, 3, 140, 70, 4, 750, 751, 10, 2, 0, 0, 751, 752, 5, 44,
-		0, 0, 752, 757, 3, 140, 70, 3, 753, 754, 10, 1, 0, 0, 754, 755, 5, 43,
-		0, 0, 755, 757, 3, 140, 70, 2, 756, 741, 1, 0, 0, 0, 756, 744, 1, 0, 0,
-		0, 756, 747, 1, 0, 0, 0, 756, 750, 1, 0, 0, 0, 756, 753, 1, 0, 0, 0, 757,
-		760, 1, 0, 0, 0, 758, 756, 1, 0, 0, 0, 758, 759, 1, 0, 0, 0, 759, 141,
-		1, 0, 0, 0, 760, 758, 1, 0, 0, 0, 761, 762, 6, 71, -1, 0, 762, 766, 3,
-		148, 74, 0, 763, 766, 3, 144, 72, 0, 764, 766, 3, 192, 96, 0, 765, 761,
-		1, 0, 0, 0, 765, 763, 1, 0, 0, 0, 765, 764, 1, 0, 0, 0, 766, 778, 1, 0,
-		0, 0, 767, 774, 10, 1, 0, 0, 768, 769, 5, 38, 0, 0, 769, 775, 5, 27, 0,
-		0, 770, 775, 3, 184, 92, 0, 771, 775, 3, 186, 93, 0, 772, 775, 3, 188,
-		94, 0, 773, 775, 3, 190, 95, 0, 774, 768, 1, 0, 0, 0, 774, 770, 1, 0, 0,
-		0, 774, 771, 1, 0, 0, 0, 774, 772, 1, 0, 0, 0, 774, 773, 1, 0, 0, 0, 775,
-		777, 1, 0, 0, 0, 776, 767, 1, 0, 0, 0, 777, 780, 1, 0, 0, 0, 778, 776,
-		1, 0, 0, 0, 778, 779, 1, 0, 0, 0, 779, 143, 1, 0, 0, 0, 780, 778, 1, 0,
-		0, 0, 781, 782, 3, 146, 73, 0, 782, 783, 5, 28, 0, 0, 783, 785, 3, 140,
-		70, 0, 784, 786, 5, 35, 0, 0, 785, 784, 1, 0, 0, 0, 785, 786, 1, 0, 0,
-		0, 786, 787, 1, 0, 0, 0, 787, 788, 5, 29, 0, 0, 788, 145, 1, 0, 0, 0, 789,
-		795, 3, 110, 55, 0, 790, 791, 5, 28, 0, 0, 791, 792, 3, 146, 73, 0, 792,
-		793, 5, 29, 0, 0, 793, 795, 1, 0, 0, 0, 794, 789, 1, 0, 0, 0, 794, 790,
-		1, 0, 0, 0, 795, 147, 1, 0, 0, 0, 796, 803, 3, 150, 75, 0, 797, 803, 3,
-		156, 78, 0, 798, 799, 5, 28, 0, 0, 799, 800, 3, 140, 70, 0, 800, 801, 5,
-		29, 0, 0, 801, 803, 1, 0, 0, 0, 802, 796, 1, 0, 0, 0, 802, 797, 1, 0, 0,
-		0, 802, 798, 1, 0, 0, 0, 803, 149, 1, 0, 0, 0, 804, 808, 3, 152, 76, 0,
-		805, 808, 3, 160, 80, 0, 806, 808, 3, 182, 91, 0, 807, 804, 1, 0, 0, 0,
-		807, 805, 1, 0, 0, 0, 807, 806, 1, 0, 0, 0, 808, 151, 1, 0, 0, 0, 809,
-		814, 5, 26, 0, 0, 810, 814, 3, 154, 77, 0, 811, 814, 3, 178, 89, 0, 812,
-		814, 5, 68, 0, 0, 813, 809, 1, 0, 0, 0, 813, 810, 1, 0, 0, 0, 813, 811,
-		1, 0, 0, 0, 813, 812, 1, 0, 0, 0, 814, 153, 1, 0, 0, 0, 815, 816, 7, 8,
-		0, 0, 816, 155, 1, 0, 0, 0, 817, 818, 5, 27, 0, 0, 818, 157, 1, 0, 0, 0,
-		819, 820, 5, 27, 0, 0, 820, 821, 5, 38, 0, 0, 821, 822, 5, 27, 0, 0, 822,
-		159, 1, 0, 0, 0, 823, 824, 3, 162, 81, 0, 824, 825, 3, 164, 82, 0, 825,
-		161, 1, 0, 0, 0, 826, 836, 3, 174, 87, 0, 827, 836, 3, 112, 56, 0, 828,
-		829, 5, 32, 0, 0, 829, 830, 5, 42, 0, 0, 830, 831, 5, 33, 0, 0, 831, 836,
-		3, 116, 58, 0, 832, 836, 3, 122, 61, 0, 833, 836, 3, 124, 62, 0, 834, 836,
-		3, 108, 54, 0, 835, 826, 1, 0, 0, 0, 835, 827, 1, 0, 0, 0, 835, 828, 1,
-		0, 0, 0, 835, 832, 1, 0, 0, 0, 835, 833, 1, 0, 0, 0, 835, 834, 1, 0, 0,
-		0, 836, 163, 1, 0, 0, 0, 837, 842, 5, 30, 0, 0, 838, 840, 3, 166, 83, 0,
-		839, 841, 5, 35, 0, 0, 840, 839, 1, 0, 0, 0, 840, 841, 1, 0, 0, 0, 841,
-		843, 1, 0, 0, 0, 842, 838, 1, 0, 0, 0, 842, 843, 1, 0, 0, 0, 843, 844,
-		1, 0, 0, 0, 844, 845, 5, 31, 0, 0, 845, 165, 1, 0, 0, 0, 846, 851, 3, 168,
-		84, 0, 847, 848, 5, 35, 0, 0, 848, 850, 3, 168, 84, 0, 849, 847, 1, 0,
-		0, 0, 850, 853, 1, 0, 0, 0, 851, 849, 1, 0, 0, 0, 851, 852, 1, 0, 0, 0,
-		852, 167, 1, 0, 0, 0, 853, 851, 1, 0, 0, 0, 854, 855, 3, 170, 85, 0, 855,
-		856, 5, 37, 0, 0, 856, 858, 1, 0, 0, 0, 857, 854, 1, 0, 0, 0, 857, 858,
-		1, 0, 0, 0, 858, 859, 1, 0, 0, 0, 859, 860, 3, 172, 86, 0, 860, 169, 1,
-		0, 0, 0, 861, 864, 3, 140, 70, 0, 862, 864, 3, 164, 82, 0, 863, 861, 1,
-		0, 0, 0, 863, 862, 1, 0, 0, 0, 864, 171, 1, 0, 0, 0, 865, 868, 3, 140,
-		70, 0, 866, 868, 3, 164, 82, 0, 867, 865, 1, 0, 0, 0, 867, 866, 1, 0, 0,
-		0, 868, 173, 1, 0, 0, 0, 869, 870, 5, 10, 0, 0, 870, 876, 5, 30, 0, 0,
-		871, 872, 3, 176, 88, 0, 872, 873, 3, 196, 98, 0, 873, 875, 1, 0, 0, 0,
-		874, 871, 1, 0, 0, 0, 875, 878, 1, 0, 0, 0, 876, 874, 1, 0, 0, 0, 876,
-		877, 1, 0, 0, 0, 877, 879, 1, 0, 0, 0, 878, 876, 1, 0, 0, 0, 879, 880,
-		5, 31, 0, 0, 880, 175, 1, 0, 0, 0, 881, 882, 3, 16, 8, 0, 882, 883, 3,
-		106, 53, 0, 883, 886, 1, 0, 0, 0, 884, 886, 3, 180, 90, 0, 885, 881, 1,
-		0, 0, 0, 885, 884, 1, 0, 0, 0, 886, 888, 1, 0, 0, 0, 887, 889, 3, 178,
-		89, 0, 888, 887, 1, 0, 0, 0, 888, 889, 1, 0, 0, 0, 889, 177, 1, 0, 0, 0,
-		890, 891, 7, 9, 0, 0, 891, 179, 1, 0, 0, 0, 892, 894, 5, 61, 0, 0, 893,
-		892, 1, 0, 0, 0, 893, 894, 1, 0, 0, 0, 894, 895, 1, 0, 0, 0, 895, 896,
-		3, 108, 54, 0, 896, 181, 1, 0, 0, 0, 897, 898, 5, 3, 0, 0, 898, 899, 3,
-		132, 66, 0, 899, 900, 3, 34, 17, 0, 900, 183, 1, 0, 0, 0, 901, 902, 5,
-		32, 0, 0, 902, 903, 3, 140, 70, 0, 903, 904, 5, 33, 0, 0, 904, 185, 1,
-		0, 0, 0, 905, 921, 5, 32, 0, 0, 906, 908, 3, 140, 70, 0, 907, 906, 1, 0,
-		0, 0, 907, 908, 1, 0, 0, 0, 908, 909, 1, 0, 0, 0, 909, 911, 5, 37, 0, 0,
-		910, 912, 3, 140, 70, 0, 911, 910, 1, 0, 0, 0, 911, 912, 1, 0, 0, 0, 912,
-		922, 1, 0, 0, 0, 913, 915, 3, 140, 70, 0, 914, 913, 1, 0, 0, 0, 914, 915,
-		1, 0, 0, 0, 915, 916, 1, 0, 0, 0, 916, 917, 5, 37, 0, 0, 917, 918, 3, 140,
-		70, 0, 918, 919, 5, 37, 0, 0, 919, 920, 3, 140, 70, 0, 920, 922, 1, 0,
-		0, 0, 921, 907, 1, 0, 0, 0, 921, 914, 1, 0, 0, 0, 922, 923, 1, 0, 0, 0,
-		923, 924, 5, 33, 0, 0, 924, 187, 1, 0, 0, 0, 925, 926, 5, 38, 0, 0, 926,
-		927, 5, 28, 0, 0, 927, 928, 3, 106, 53, 0, 928, 929, 5, 29, 0, 0, 929,
-		189, 1, 0, 0, 0, 930, 945, 5, 28, 0, 0, 931, 938, 3, 18, 9, 0, 932, 935,
-		3, 146, 73, 0, 933, 934, 5, 35, 0, 0, 934, 936, 3, 18, 9, 0, 935, 933,
-		1, 0, 0, 0, 935, 936, 1, 0, 0, 0, 936, 938, 1, 0, 0, 0, 937, 931, 1, 0,
-		0, 0, 937, 932, 1, 0, 0, 0, 938, 940, 1, 0, 0, 0, 939, 941, 5, 42, 0, 0,
-		940, 939, 1, 0, 0, 0, 940, 941, 1, 0, 0, 0, 941, 943, 1, 0, 0, 0, 942,
-		944, 5, 35, 0, 0, 943, 942, 1, 0, 0, 0, 943, 944, 1, 0, 0, 0, 944, 946,
-		1, 0, 0, 0, 945, 937, 1, 0, 0, 0, 945, 946, 1, 0, 0, 0, 946, 947, 1, 0,
-		0, 0, 947, 948, 5, 29, 0, 0, 948, 191, 1, 0, 0, 0, 949, 950, 3, 146, 73,
-		0, 950, 951, 5, 38, 0, 0, 951, 952, 5, 27, 0, 0, 952, 193, 1, 0, 0, 0,
-		953, 954, 3, 106, 53, 0, 954, 195, 1, 0, 0, 0, 955, 960, 5, 36, 0, 0, 956,
-		960, 5, 0, 0, 1, 957, 960, 5, 87, 0, 0, 958, 960, 4, 98, 7, 0, 959, 955,
-		1, 0, 0, 0, 959, 956, 1, 0, 0, 0, 959, 957, 1, 0, 0, 0, 959, 958, 1, 0,
-		0, 0, 960, 197, 1, 0, 0, 0, 111, 205, 211, 217, 233, 237, 240, 249, 259,
-		263, 267, 271, 278, 286, 297, 301, 305, 313, 320, 332, 336, 342, 346, 350,
-		355, 358, 361, 368, 385, 392, 408, 421, 425, 429, 433, 452, 458, 460, 464,
-		468, 471, 475, 477, 483, 491, 496, 507, 513, 520, 531, 536, 540, 545, 549,
-		557, 565, 570, 573, 581, 587, 591, 593, 598, 602, 606, 614, 628, 632, 642,
-		660, 666, 686, 696, 705, 709, 717, 721, 723, 728, 731, 739, 756, 758, 765,
-		774, 778, 785, 794, 802, 807, 813, 835, 840, 842, 851, 857, 863, 867, 876,
-		885, 888, 893, 907, 911, 914, 921, 935, 937, 940, 943, 945, 959,
+		5, 2, 242, 8, 2, 10, 2, 12, 2, 245, 9, 2, 1, 2, 3, 2, 248, 8, 2, 1, 3,
+		3, 3, 251, 8, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 5, 1, 5, 1, 5, 3, 5, 260, 8,
+		5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 5, 6, 268, 8, 6, 10, 6, 12, 6, 271,
+		9, 6, 1, 6, 3, 6, 274, 8, 6, 1, 7, 1, 7, 3, 7, 278, 8, 7, 1, 7, 1, 7, 3,
+		7, 282, 8, 7, 1, 8, 1, 8, 1, 8, 5, 8, 287, 8, 8, 10, 8, 12, 8, 290, 9,
+		8, 1, 9, 1, 9, 1, 9, 5, 9, 295, 8, 9, 10, 9, 12, 9, 298, 9, 9, 1, 10, 1,
+		10, 1, 10, 1, 10, 1, 10, 1, 10, 5, 10, 306, 8, 10, 10, 10, 12, 10, 309,
+		9, 10, 1, 10, 3, 10, 312, 8, 10, 1, 11, 1, 11, 3, 11, 316, 8, 11, 1, 11,
+		3, 11, 319, 8, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1, 12, 1, 12, 5, 12, 327,
+		8, 12, 10, 12, 12, 12, 330, 9, 12, 1, 12, 3, 12, 333, 8, 12, 1, 12, 1,
+		12, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 5, 14, 343, 8, 14, 10, 14,
+		12, 14, 346, 9, 14, 1, 15, 3, 15, 349, 8, 15, 1, 15, 1, 15, 1, 16, 1, 16,
+		1, 16, 3, 16, 356, 8, 16, 1, 16, 1, 16, 3, 16, 360, 8, 16, 1, 17, 1, 17,
+		1, 17, 1, 17, 1, 17, 3, 17, 367, 8, 17, 1, 18, 1, 18, 1, 19, 1, 19, 1,
+		19, 1, 19, 1, 19, 1, 19, 5, 19, 377, 8, 19, 10, 19, 12, 19, 380, 9, 19,
+		1, 19, 3, 19, 383, 8, 19, 1, 20, 1, 20, 1, 20, 1, 20, 3, 20, 389, 8, 20,
+		1, 20, 1, 20, 3, 20, 393, 8, 20, 1, 21, 1, 21, 3, 21, 397, 8, 21, 1, 21,
+		1, 21, 1, 22, 3, 22, 402, 8, 22, 1, 22, 3, 22, 405, 8, 22, 1, 22, 3, 22,
+		408, 8, 22, 1, 22, 1, 22, 1, 22, 4, 22, 413, 8, 22, 11, 22, 12, 22, 414,
+		1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1, 23, 1,
+		23, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 432, 8, 23, 1, 24, 1, 24, 1, 24,
+		1, 24, 1, 24, 3, 24, 439, 8, 24, 1, 25, 1, 25, 1, 26, 1, 26, 1, 26, 1,
+		26, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1, 28, 1, 28, 1, 29, 3, 29, 455,
+		8, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 32, 1,
+		32, 1, 32, 3, 32, 468, 8, 32, 1, 33, 1, 33, 3, 33, 472, 8, 33, 1, 34, 1,
+		34, 3, 34, 476, 8, 34, 1, 35, 1, 35, 3, 35, 480, 8, 35, 1, 36, 1, 36, 1,
+		36, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 39, 1, 39, 1, 39, 1, 39, 1, 39,
+		1, 39, 1, 39, 1, 39, 1, 39, 3, 39, 499, 8, 39, 1, 39, 1, 39, 1, 39, 1,
+		39, 3, 39, 505, 8, 39, 3, 39, 507, 8, 39, 1, 40, 1, 40, 3, 40, 511, 8,
+		40, 1, 41, 1, 41, 3, 41, 515, 8, 41, 1, 41, 3, 41, 518, 8, 41, 1, 41, 1,
+		41, 3, 41, 522, 8, 41, 3, 41, 524, 8, 41, 1, 41, 1, 41, 5, 41, 528, 8,
+		41, 10, 41, 12, 41, 531, 9, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 3, 42,
+		538, 8, 42, 1, 43, 1, 43, 1, 43, 3, 43, 543, 8, 43, 1, 44, 1, 44, 1, 44,
+		1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 1, 44, 3, 44, 554, 8, 44, 1, 44, 1,
+		44, 5, 44, 558, 8, 44, 10, 44, 12, 44, 561, 9, 44, 1, 44, 1, 44, 1, 45,
+		1, 45, 3, 45, 567, 8, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1, 45, 1,
+		46, 1, 46, 1, 46, 3, 46, 578, 8, 46, 1, 47, 1, 47, 1, 47, 3, 47, 583, 8,
+		47, 1, 48, 1, 48, 3, 48, 587, 8, 48, 1, 48, 1, 48, 1, 48, 3, 48, 592, 8,
+		48, 5, 48, 594, 8, 48, 10, 48, 12, 48, 597, 9, 48, 1, 49, 1, 49, 1, 49,
+		5, 49, 602, 8, 49, 10, 49, 12, 49, 605, 9, 49, 1, 49, 1, 49, 1, 50, 1,
+		50, 1, 50, 3, 50, 612, 8, 50, 1, 51, 1, 51, 1, 51, 3, 51, 617, 8, 51, 1,
+		51, 3, 51, 620, 8, 51, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52,
+		628, 8, 52, 1, 52, 1, 52, 1, 53, 1, 53, 3, 53, 634, 8, 53, 1, 53, 1, 53,
+		3, 53, 638, 8, 53, 3, 53, 640, 8, 53, 1, 53, 1, 53, 1, 54, 3, 54, 645,
+		8, 54, 1, 54, 1, 54, 3, 54, 649, 8, 54, 1, 54, 1, 54, 3, 54, 653, 8, 54,
+		1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 1, 55, 3, 55, 661, 8, 55, 1, 55, 1,
+		55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 57, 1, 57, 3, 57, 671, 8, 57, 1, 57,
+		1, 57, 1, 57, 1, 57, 1, 57, 3, 57, 678, 8, 57, 1, 58, 1, 58, 1, 58, 3,
+		58, 683, 8, 58, 1, 58, 1, 58, 1, 59, 1, 59, 3, 59, 689, 8, 59, 1, 60, 1,
+		60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 3, 60, 699, 8, 60, 1, 61,
+		1, 61, 1, 61, 1, 61, 1, 61, 1, 62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1,
+		64, 1, 65, 1, 65, 1, 65, 1, 65, 3, 65, 717, 8, 65, 1, 65, 1, 65, 5, 65,
+		721, 8, 65, 10, 65, 12, 65, 724, 9, 65, 1, 65, 1, 65, 1, 66, 1, 66, 1,
+		66, 1, 66, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 67, 1, 68, 1, 68, 1, 68,
+		1, 68, 1, 68, 3, 68, 743, 8, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1,
+		69, 1, 69, 1, 69, 3, 69, 753, 8, 69, 1, 70, 1, 70, 1, 70, 1, 71, 1, 71,
+		1, 71, 1, 71, 3, 71, 762, 8, 71, 1, 72, 1, 72, 3, 72, 766, 8, 72, 1, 73,
+		1, 73, 1, 73, 1, 73, 5, 73, 772, 8, 73, 10, 73, 12, 73, 775, 9, 73, 1,
+		73, 3, 73, 778, 8, 73, 3, 73, 780, 8, 73, 1, 73, 1, 73, 1, 74, 3, 74, 785,
+		8, 74, 1, 74, 3, 74, 788, 8, 74, 1, 74, 1, 74, 1, 75, 1, 75, 1, 75, 1,
+		75, 3, 75, 796, 8, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75,
+		1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 1, 75, 5, 75, 813, 8,
+		75, 10, 75, 12, 75, 816, 9, 75, 1, 76, 1, 76, 1, 76, 1, 76, 3, 76, 822,
+		8, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 1, 76, 3, 76, 831, 8,
+		76, 5, 76, 833, 8, 76, 10, 76, 12, 76, 836, 9, 76, 1, 77, 1, 77, 1, 77,
+		1, 77, 3, 77, 842, 8, 77, 1, 77, 1, 77, 1, 78, 1, 78, 1, 78, 1, 78, 1,
+		78, 3, 78, 851, 8, 78, 1, 79, 1, 79, 1, 79, 3, 79, 856, 8, 79, 1, 79, 1,
+		79, 1, 79, 1, 79, 3, 79, 862, 8, 79, 1, 80, 1, 80, 1, 80, 3, 80, 867, 8,
+		80, 1, 81, 1, 81, 1, 81, 1, 81, 3, 81, 873, 8, 81, 1, 82, 1, 82, 1, 83,
+		1, 83, 1, 84, 1, 84, 1, 84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1,
+		86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 1, 86, 3, 86, 896, 8, 86,
+		3, 86, 898, 8, 86, 1, 87, 1, 87, 1, 87, 3, 87, 903, 8, 87, 3, 87, 905,
+		8, 87, 1, 87, 1, 87, 1, 88, 1, 88, 1, 88, 5, 88, 912, 8, 88, 10, 88, 12,
+		88, 915, 9, 88, 1, 89, 1, 89, 1, 89, 3, 89, 920, 8, 89, 1, 89, 1, 89, 1,
+		90, 1, 90, 3, 90, 926, 8, 90, 1, 91, 1, 91, 3, 91, 930, 8, 91, 1, 92, 1,
+		92, 1, 92, 1, 92, 1, 92, 5, 92, 937, 8, 92, 10, 92, 12, 92, 940, 9, 92,
+		1, 92, 1, 92, 1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 948, 8, 93, 1, 93, 3,
+		93, 951, 8, 93, 1, 94, 1, 94, 1, 95, 3, 95, 956, 8, 95, 1, 95, 1, 95, 3,
+		95, 960, 8, 95, 1, 96, 1, 96, 1, 96, 1, 96, 1, 97, 1, 97, 1, 97, 1, 97,
+		1, 98, 1, 98, 3, 98, 972, 8, 98, 1, 98, 1, 98, 3, 98, 976, 8, 98, 1, 98,
+		3, 98, 979, 8, 98, 1, 98, 1, 98, 1, 98, 1, 98, 1, 98, 3, 98, 986, 8, 98,
+		1, 98, 1, 98, 1, 99, 1, 99, 1, 99, 1, 99, 1, 99, 1, 100, 1, 100, 1, 100,
+		1, 100, 1, 100, 3, 100, 1000, 8, 100, 3, 100, 1002, 8, 100, 1, 100, 3,
+		100, 1005, 8, 100, 1, 100, 3, 100, 1008, 8, 100, 3, 100, 1010, 8, 100,
+		1, 100, 1, 100, 1, 101, 1, 101, 1, 101, 1, 101, 1, 102, 1, 102, 1, 103,
+		1, 103, 1, 103, 1, 103, 3, 103, 1024, 8, 103, 1, 103, 0, 2, 150, 152, 104,
+		0, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36,
+		38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72,
+		74, 76, 78, 80, 82, 84, 86, 88, 90, 92, 94, 96, 98, 100, 102, 104, 106,
+		108, 110, 112, 114, 116, 118, 120, 122, 124, 126, 128, 130, 132, 134, 136,
+		138, 140, 142, 144, 146, 148, 150, 152, 154, 156, 158, 160, 162, 164, 166,
+		168, 170, 172, 174, 176, 178, 180, 182, 184, 186, 188, 190, 192, 194, 196,
+		198, 200, 202, 204, 206, 0, 10, 2, 0, 27, 27, 38, 38, 1, 0, 39, 40, 2,
+		0, 51, 56, 58, 62, 2, 0, 36, 36, 88, 88, 1, 0, 57, 63, 2, 0, 52, 56, 61,
+		62, 2, 0, 51, 51, 58, 60, 1, 0, 45, 50, 2, 0, 65, 68, 72, 73, 1, 0, 79,
+		80, 1092, 0, 208, 1, 0, 0, 0, 2, 232, 1, 0, 0, 0, 4, 235, 1, 0, 0, 0, 6,
+		250, 1, 0, 0, 0, 8, 254, 1, 0, 0, 0, 10, 259, 1, 0, 0, 0, 12, 261, 1, 0,
+		0, 0, 14, 275, 1, 0, 0, 0, 16, 283, 1, 0, 0, 0, 18, 291, 1, 0, 0, 0, 20,
+		299, 1, 0, 0, 0, 22, 313, 1, 0, 0, 0, 24, 322, 1, 0, 0, 0, 26, 336, 1,
+		0, 0, 0, 28, 339, 1, 0, 0, 0, 30, 348, 1, 0, 0, 0, 32, 352, 1, 0, 0, 0,
+		34, 361, 1, 0, 0, 0, 36, 368, 1, 0, 0, 0, 38, 370, 1, 0, 0, 0, 40, 384,
+		1, 0, 0, 0, 42, 394, 1, 0, 0, 0, 44, 412, 1, 0, 0, 0, 46, 431, 1, 0, 0,
+		0, 48, 438, 1, 0, 0, 0, 50, 440, 1, 0, 0, 0, 52, 442, 1, 0, 0, 0, 54, 446,
+		1, 0, 0, 0, 56, 449, 1, 0, 0, 0, 58, 454, 1, 0, 0, 0, 60, 458, 1, 0, 0,
+		0, 62, 462, 1, 0, 0, 0, 64, 464, 1, 0, 0, 0, 66, 469, 1, 0, 0, 0, 68, 473,
+		1, 0, 0, 0, 70, 477, 1, 0, 0, 0, 72, 481, 1, 0, 0, 0, 74, 484, 1, 0, 0,
+		0, 76, 486, 1, 0, 0, 0, 78, 489, 1, 0, 0, 0, 80, 510, 1, 0, 0, 0, 82, 512,
+		1, 0, 0, 0, 84, 534, 1, 0, 0, 0, 86, 542, 1, 0, 0, 0, 88, 544, 1, 0, 0,
+		0, 90, 566, 1, 0, 0, 0, 92, 574, 1, 0, 0, 0, 94, 582, 1, 0, 0, 0, 96, 586,
+		1, 0, 0, 0, 98, 598, 1, 0, 0, 0, 100, 608, 1, 0, 0, 0, 102, 619, 1, 0,
+		0, 0, 104, 627, 1, 0, 0, 0, 106, 631, 1, 0, 0, 0, 108, 644, 1, 0, 0, 0,
+		110, 660, 1, 0, 0, 0, 112, 665, 1, 0, 0, 0, 114, 677, 1, 0, 0, 0, 116,
+		679, 1, 0, 0, 0, 118, 688, 1, 0, 0, 0, 120, 698, 1, 0, 0, 0, 122, 700,
+		1, 0, 0, 0, 124, 705, 1, 0, 0, 0, 126, 707, 1, 0, 0, 0, 128, 709, 1, 0,
+		0, 0, 130, 712, 1, 0, 0, 0, 132, 727, 1, 0, 0, 0, 134, 731, 1, 0, 0, 0,
+		136, 742, 1, 0, 0, 0, 138, 752, 1, 0, 0, 0, 140, 754, 1, 0, 0, 0, 142,
+		761, 1, 0, 0, 0, 144, 765, 1, 0, 0, 0, 146, 767, 1, 0, 0, 0, 148, 784,
+		1, 0, 0, 0, 150, 795, 1, 0, 0, 0, 152, 821, 1, 0, 0, 0, 154, 837, 1, 0,
+		0, 0, 156, 850, 1, 0, 0, 0, 158, 861, 1, 0, 0, 0, 160, 866, 1, 0, 0, 0,
+		162, 872, 1, 0, 0, 0, 164, 874, 1, 0, 0, 0, 166, 876, 1, 0, 0, 0, 168,
+		878, 1, 0, 0, 0, 170, 882, 1, 0, 0, 0, 172, 897, 1, 0, 0, 0, 174, 899,
+		1, 0, 0, 0, 176, 908, 1, 0, 0, 0, 178, 919, 1, 0, 0, 0, 180, 925, 1, 0,
+		0, 0, 182, 929, 1, 0, 0, 0, 184, 931, 1, 0, 0, 0, 186, 947, 1, 0, 0, 0,
+		188, 952, 1, 0, 0, 0, 190, 955, 1, 0, 0, 0, 192, 961, 1, 0, 0, 0, 194,
+		965, 1, 0, 0, 0, 196, 969, 1, 0, 0, 0, 198, 989, 1, 0, 0, 0, 200, 994,
+		1, 0, 0, 0, 202, 1013, 1, 0, 0, 0, 204, 1017, 1, 0, 0, 0, 206, 1023, 1,
+		0, 0, 0, 208, 209, 3, 2, 1, 0, 209, 215, 3, 206, 103, 0, 210, 211, 3, 4,
+		2, 0, 211, 212, 3, 206, 103, 0, 212, 214, 1, 0, 0, 0, 213, 210, 1, 0, 0,
+		0, 214, 217, 1, 0, 0, 0, 215, 213, 1, 0, 0, 0, 215, 216, 1, 0, 0, 0, 216,
+		227, 1, 0, 0, 0, 217, 215, 1, 0, 0, 0, 218, 222, 3, 32, 16, 0, 219, 222,
+		3, 34, 17, 0, 220, 222, 3, 10, 5, 0, 221, 218, 1, 0, 0, 0, 221, 219, 1,
+		0, 0, 0, 221, 220, 1, 0, 0, 0, 222, 223, 1, 0, 0, 0, 223, 224, 3, 206,
+		103, 0, 224, 226, 1, 0, 0, 0, 225, 221, 1, 0, 0, 0, 226, 229, 1, 0, 0,
+		0, 227, 225, 1, 0, 0, 0, 227, 228, 1, 0, 0, 0, 228, 230, 1, 0, 0, 0, 229,
+		227, 1, 0, 0, 0, 230, 231, 5, 0, 0, 1, 231, 1, 1, 0, 0, 0, 232, 233, 5,
+		14, 0, 0, 233, 234, 5, 27, 0, 0, 234, 3, 1, 0, 0, 0, 235, 247, 5, 23, 0,
+		0, 236, 248, 3, 6, 3, 0, 237, 243, 5, 28, 0, 0, 238, 239, 3, 6, 3, 0, 239,
+		240, 3, 206, 103, 0, 240, 242, 1, 0, 0, 0, 241, 238, 1, 0, 0, 0, 242, 245,
+		1, 0, 0, 0, 243, 241, 1, 0, 0, 0, 243, 244, 1, 0, 0, 0, 244, 246, 1, 0,
+		0, 0, 245, 243, 1, 0, 0, 0, 246, 248, 5, 29, 0, 0, 247, 236, 1, 0, 0, 0,
+		247, 237, 1, 0, 0, 0, 248, 5, 1, 0, 0, 0, 249, 251, 7, 0, 0, 0, 250, 249,
+		1, 0, 0, 0, 250, 251, 1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 253, 3, 8,
+		4, 0, 253, 7, 1, 0, 0, 0, 254, 255, 3, 188, 94, 0, 255, 9, 1, 0, 0, 0,
+		256, 260, 3, 12, 6, 0, 257, 260, 3, 20, 10, 0, 258, 260, 3, 38, 19, 0,
+		259, 256, 1, 0, 0, 0, 259, 257, 1, 0, 0, 0, 259, 258, 1, 0, 0, 0, 260,
+		11, 1, 0, 0, 0, 261, 273, 5, 16, 0, 0, 262, 274, 3, 14, 7, 0, 263, 269,
+		5, 28, 0, 0, 264, 265, 3, 14, 7, 0, 265, 266, 3, 206, 103, 0, 266, 268,
+		1, 0, 0, 0, 267, 264, 1, 0, 0, 0, 268, 271, 1, 0, 0, 0, 269, 267, 1, 0,
+		0, 0, 269, 270, 1, 0, 0, 0, 270, 272, 1, 0, 0, 0, 271, 269, 1, 0, 0, 0,
+		272, 274, 5, 29, 0, 0, 273, 262, 1, 0, 0, 0, 273, 263, 1, 0, 0, 0, 274,
+		13, 1, 0, 0, 0, 275, 281, 3, 16, 8, 0, 276, 278, 3, 114, 57, 0, 277, 276,
+		1, 0, 0, 0, 277, 278, 1, 0, 0, 0, 278, 279, 1, 0, 0, 0, 279, 280, 5, 34,
+		0, 0, 280, 282, 3, 18, 9, 0, 281, 277, 1, 0, 0, 0, 281, 282, 1, 0, 0, 0,
+		282, 15, 1, 0, 0, 0, 283, 288, 5, 27, 0, 0, 284, 285, 5, 35, 0, 0, 285,
+		287, 5, 27, 0, 0, 286, 284, 1, 0, 0, 0, 287, 290, 1, 0, 0, 0, 288, 286,
+		1, 0, 0, 0, 288, 289, 1, 0, 0, 0, 289, 17, 1, 0, 0, 0, 290, 288, 1, 0,
+		0, 0, 291, 296, 3, 150, 75, 0, 292, 293, 5, 35, 0, 0, 293, 295, 3, 150,
+		75, 0, 294, 292, 1, 0, 0, 0, 295, 298, 1, 0, 0, 0, 296, 294, 1, 0, 0, 0,
+		296, 297, 1, 0, 0, 0, 297, 19, 1, 0, 0, 0, 298, 296, 1, 0, 0, 0, 299, 311,
+		5, 20, 0, 0, 300, 312, 3, 22, 11, 0, 301, 307, 5, 28, 0, 0, 302, 303, 3,
+		22, 11, 0, 303, 304, 3, 206, 103, 0, 304, 306, 1, 0, 0, 0, 305, 302, 1,
+		0, 0, 0, 306, 309, 1, 0, 0, 0, 307, 305, 1, 0, 0, 0, 307, 308, 1, 0, 0,
+		0, 308, 310, 1, 0, 0, 0, 309, 307, 1, 0, 0, 0, 310, 312, 5, 29, 0, 0, 311,
+		300, 1, 0, 0, 0, 311, 301, 1, 0, 0, 0, 312, 21, 1, 0, 0, 0, 313, 315, 5,
+		27, 0, 0, 314, 316, 3, 24, 12, 0, 315, 314, 1, 0, 0, 0, 315, 316, 1, 0,
+		0, 0, 316, 318, 1, 0, 0, 0, 317, 319, 5, 34, 0, 0, 318, 317, 1, 0, 0, 0,
+		318, 319, 1, 0, 0, 0, 319, 320, 1, 0, 0, 0, 320, 321, 3, 114, 57, 0, 321,
+		23, 1, 0, 0, 0, 322, 323, 5, 32, 0, 0, 323, 328, 3, 26, 13, 0, 324, 325,
+		5, 35, 0, 0, 325, 327, 3, 26, 13, 0, 326, 324, 1, 0, 0, 0, 327, 330, 1,
+		0, 0, 0, 328, 326, 1, 0, 0, 0, 328, 329, 1, 0, 0, 0, 329, 332, 1, 0, 0,
+		0, 330, 328, 1, 0, 0, 0, 331, 333, 5, 35, 0, 0, 332, 331, 1, 0, 0, 0, 332,
+		333, 1, 0, 0, 0, 333, 334, 1, 0, 0, 0, 334, 335, 5, 33, 0, 0, 335, 25,
+		1, 0, 0, 0, 336, 337, 3, 16, 8, 0, 337, 338, 3, 28, 14, 0, 338, 27, 1,
+		0, 0, 0, 339, 344, 3, 30, 15, 0, 340, 341, 5, 51, 0, 0, 341, 343, 3, 30,
+		15, 0, 342, 340, 1, 0, 0, 0, 343, 346, 1, 0, 0, 0, 344, 342, 1, 0, 0, 0,
+		344, 345, 1, 0, 0, 0, 345, 29, 1, 0, 0, 0, 346, 344, 1, 0, 0, 0, 347, 349,
+		5, 64, 0, 0, 348, 347, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 350, 1, 0,
+		0, 0, 350, 351, 3, 114, 57, 0, 351, 31, 1, 0, 0, 0, 352, 353, 5, 3, 0,
+		0, 353, 355, 5, 27, 0, 0, 354, 356, 3, 24, 12, 0, 355, 354, 1, 0, 0, 0,
+		355, 356, 1, 0, 0, 0, 356, 357, 1, 0, 0, 0, 357, 359, 3, 142, 71, 0, 358,
+		360, 3, 42, 21, 0, 359, 358, 1, 0, 0, 0, 359, 360, 1, 0, 0, 0, 360, 33,
+		1, 0, 0, 0, 361, 362, 5, 3, 0, 0, 362, 363, 3, 36, 18, 0, 363, 364, 5,
+		27, 0, 0, 364, 366, 3, 142, 71, 0, 365, 367, 3, 42, 21, 0, 366, 365, 1,
+		0, 0, 0, 366, 367, 1, 0, 0, 0, 367, 35, 1, 0, 0, 0, 368, 369, 3, 146, 73,
+		0, 369, 37, 1, 0, 0, 0, 370, 382, 5, 25, 0, 0, 371, 383, 3, 40, 20, 0,
+		372, 378, 5, 28, 0, 0, 373, 374, 3, 40, 20, 0, 374, 375, 3, 206, 103, 0,
+		375, 377, 1, 0, 0, 0, 376, 373, 1, 0, 0, 0, 377, 380, 1, 0, 0, 0, 378,
+		376, 1, 0, 0, 0, 378, 379, 1, 0, 0, 0, 379, 381, 1, 0, 0, 0, 380, 378,
+		1, 0, 0, 0, 381, 383, 5, 29, 0, 0, 382, 371, 1, 0, 0, 0, 382, 372, 1, 0,
+		0, 0, 383, 39, 1, 0, 0, 0, 384, 392, 3, 16, 8, 0, 385, 388, 3, 114, 57,
+		0, 386, 387, 5, 34, 0, 0, 387, 389, 3, 18, 9, 0, 388, 386, 1, 0, 0, 0,
+		388, 389, 1, 0, 0, 0, 389, 393, 1, 0, 0, 0, 390, 391, 5, 34, 0, 0, 391,
+		393, 3, 18, 9, 0, 392, 385, 1, 0, 0, 0, 392, 390, 1, 0, 0, 0, 393, 41,
+		1, 0, 0, 0, 394, 396, 5, 30, 0, 0, 395, 397, 3, 44, 22, 0, 396, 395, 1,
+		0, 0, 0, 396, 397, 1, 0, 0, 0, 397, 398, 1, 0, 0, 0, 398, 399, 5, 31, 0,
+		0, 399, 43, 1, 0, 0, 0, 400, 402, 5, 36, 0, 0, 401, 400, 1, 0, 0, 0, 401,
+		402, 1, 0, 0, 0, 402, 408, 1, 0, 0, 0, 403, 405, 5, 88, 0, 0, 404, 403,
+		1, 0, 0, 0, 404, 405, 1, 0, 0, 0, 405, 408, 1, 0, 0, 0, 406, 408, 4, 22,
+		0, 0, 407, 401, 1, 0, 0, 0, 407, 404, 1, 0, 0, 0, 407, 406, 1, 0, 0, 0,
+		408, 409, 1, 0, 0, 0, 409, 410, 3, 46, 23, 0, 410, 411, 3, 206, 103, 0,
+		411, 413, 1, 0, 0, 0, 412, 407, 1, 0, 0, 0, 413, 414, 1, 0, 0, 0, 414,
+		412, 1, 0, 0, 0, 414, 415, 1, 0, 0, 0, 415, 45, 1, 0, 0, 0, 416, 432, 3,
+		10, 5, 0, 417, 432, 3, 64, 32, 0, 418, 432, 3, 48, 24, 0, 419, 432, 3,
+		112, 56, 0, 420, 432, 3, 66, 33, 0, 421, 432, 3, 68, 34, 0, 422, 432, 3,
+		70, 35, 0, 423, 432, 3, 72, 36, 0, 424, 432, 3, 74, 37, 0, 425, 432, 3,
+		42, 21, 0, 426, 432, 3, 78, 39, 0, 427, 432, 3, 80, 40, 0, 428, 432, 3,
+		98, 49, 0, 429, 432, 3, 106, 53, 0, 430, 432, 3, 76, 38, 0, 431, 416, 1,
+		0, 0, 0, 431, 417, 1, 0, 0, 0, 431, 418, 1, 0, 0, 0, 431, 419, 1, 0, 0,
+		0, 431, 420, 1, 0, 0, 0, 431, 421, 1, 0, 0, 0, 431, 422, 1, 0, 0, 0, 431,
+		423, 1, 0, 0, 0, 431, 424, 1, 0, 0, 0, 431, 425, 1, 0, 0, 0, 431, 426,
+		1, 0, 0, 0, 431, 427, 1, 0, 0, 0, 431, 428, 1, 0, 0, 0, 431, 429, 1, 0,
+		0, 0, 431, 430, 1, 0, 0, 0, 432, 47, 1, 0, 0, 0, 433, 439, 3, 52, 26, 0,
+		434, 439, 3, 54, 27, 0, 435, 439, 3, 56, 28, 0, 436, 439, 3, 50, 25, 0,
+		437, 439, 3, 60, 30, 0, 438, 433, 1, 0, 0, 0, 438, 434, 1, 0, 0, 0, 438,
+		435, 1, 0, 0, 0, 438, 436, 1, 0, 0, 0, 438, 437, 1, 0, 0, 0, 439, 49, 1,
+		0, 0, 0, 440, 441, 3, 150, 75, 0, 441, 51, 1, 0, 0, 0, 442, 443, 3, 150,
+		75, 0, 443, 444, 5, 63, 0, 0, 444, 445, 3, 150, 75, 0, 445, 53, 1, 0, 0,
+		0, 446, 447, 3, 150, 75, 0, 447, 448, 7, 1, 0, 0, 448, 55, 1, 0, 0, 0,
+		449, 450, 3, 18, 9, 0, 450, 451, 3, 58, 29, 0, 451, 452, 3, 18, 9, 0, 452,
+		57, 1, 0, 0, 0, 453, 455, 7, 2, 0, 0, 454, 453, 1, 0, 0, 0, 454, 455, 1,
+		0, 0, 0, 455, 456, 1, 0, 0, 0, 456, 457, 5, 34, 0, 0, 457, 59, 1, 0, 0,
+		0, 458, 459, 3, 16, 8, 0, 459, 460, 5, 41, 0, 0, 460, 461, 3, 18, 9, 0,
+		461, 61, 1, 0, 0, 0, 462, 463, 7, 3, 0, 0, 463, 63, 1, 0, 0, 0, 464, 465,
+		5, 27, 0, 0, 465, 467, 5, 37, 0, 0, 466, 468, 3, 46, 23, 0, 467, 466, 1,
+		0, 0, 0, 467, 468, 1, 0, 0, 0, 468, 65, 1, 0, 0, 0, 469, 471, 5, 24, 0,
+		0, 470, 472, 3, 18, 9, 0, 471, 470, 1, 0, 0, 0, 471, 472, 1, 0, 0, 0, 472,
+		67, 1, 0, 0, 0, 473, 475, 5, 1, 0, 0, 474, 476, 5, 27, 0, 0, 475, 474,
+		1, 0, 0, 0, 475, 476, 1, 0, 0, 0, 476, 69, 1, 0, 0, 0, 477, 479, 5, 21,
+		0, 0, 478, 480, 5, 27, 0, 0, 479, 478, 1, 0, 0, 0, 479, 480, 1, 0, 0, 0,
+		480, 71, 1, 0, 0, 0, 481, 482, 5, 13, 0, 0, 482, 483, 5, 27, 0, 0, 483,
+		73, 1, 0, 0, 0, 484, 485, 5, 17, 0, 0, 485, 75, 1, 0, 0, 0, 486, 487, 5,
+		7, 0, 0, 487, 488, 3, 150, 75, 0, 488, 77, 1, 0, 0, 0, 489, 498, 5, 18,
+		0, 0, 490, 499, 3, 150, 75, 0, 491, 492, 3, 206, 103, 0, 492, 493, 3, 150,
+		75, 0, 493, 499, 1, 0, 0, 0, 494, 495, 3, 48, 24, 0, 495, 496, 3, 206,
+		103, 0, 496, 497, 3, 150, 75, 0, 497, 499, 1, 0, 0, 0, 498, 490, 1, 0,
+		0, 0, 498, 491, 1, 0, 0, 0, 498, 494, 1, 0, 0, 0, 499, 500, 1, 0, 0, 0,
+		500, 506, 3, 42, 21, 0, 501, 504, 5, 12, 0, 0, 502, 505, 3, 78, 39, 0,
+		503, 505, 3, 42, 21, 0, 504, 502, 1, 0, 0, 0, 504, 503, 1, 0, 0, 0, 505,
+		507, 1, 0, 0, 0, 506, 501, 1, 0, 0, 0, 506, 507, 1, 0, 0, 0, 507, 79, 1,
+		0, 0, 0, 508, 511, 3, 82, 41, 0, 509, 511, 3, 88, 44, 0, 510, 508, 1, 0,
+		0, 0, 510, 509, 1, 0, 0, 0, 511, 81, 1, 0, 0, 0, 512, 523, 5, 15, 0, 0,
+		513, 515, 3, 150, 75, 0, 514, 513, 1, 0, 0, 0, 514, 515, 1, 0, 0, 0, 515,
+		524, 1, 0, 0, 0, 516, 518, 3, 48, 24, 0, 517, 516, 1, 0, 0, 0, 517, 518,
+		1, 0, 0, 0, 518, 519, 1, 0, 0, 0, 519, 521, 3, 206, 103, 0, 520, 522, 3,
+		150, 75, 0, 521, 520, 1, 0, 0, 0, 521, 522, 1, 0, 0, 0, 522, 524, 1, 0,
+		0, 0, 523, 514, 1, 0, 0, 0, 523, 517, 1, 0, 0, 0, 524, 525, 1, 0, 0, 0,
+		525, 529, 5, 30, 0, 0, 526, 528, 3, 84, 42, 0, 527, 526, 1, 0, 0, 0, 528,
+		531, 1, 0, 0, 0, 529, 527, 1, 0, 0, 0, 529, 530, 1, 0, 0, 0, 530, 532,
+		1, 0, 0, 0, 531, 529, 1, 0, 0, 0, 532, 533, 5, 31, 0, 0, 533, 83, 1, 0,
+		0, 0, 534, 535, 3, 86, 43, 0, 535, 537, 5, 37, 0, 0, 536, 538, 3, 44, 22,
+		0, 537, 536, 1, 0, 0, 0, 537, 538, 1, 0, 0, 0, 538, 85, 1, 0, 0, 0, 539,
+		540, 5, 6, 0, 0, 540, 543, 3, 18, 9, 0, 541, 543, 5, 2, 0, 0, 542, 539,
+		1, 0, 0, 0, 542, 541, 1, 0, 0, 0, 543, 87, 1, 0, 0, 0, 544, 553, 5, 15,
+		0, 0, 545, 554, 3, 90, 45, 0, 546, 547, 3, 206, 103, 0, 547, 548, 3, 90,
+		45, 0, 548, 554, 1, 0, 0, 0, 549, 550, 3, 48, 24, 0, 550, 551, 3, 206,
+		103, 0, 551, 552, 3, 90, 45, 0, 552, 554, 1, 0, 0, 0, 553, 545, 1, 0, 0,
+		0, 553, 546, 1, 0, 0, 0, 553, 549, 1, 0, 0, 0, 554, 555, 1, 0, 0, 0, 555,
+		559, 5, 30, 0, 0, 556, 558, 3, 92, 46, 0, 557, 556, 1, 0, 0, 0, 558, 561,
+		1, 0, 0, 0, 559, 557, 1, 0, 0, 0, 559, 560, 1, 0, 0, 0, 560, 562, 1, 0,
+		0, 0, 561, 559, 1, 0, 0, 0, 562, 563, 5, 31, 0, 0, 563, 89, 1, 0, 0, 0,
+		564, 565, 5, 27, 0, 0, 565, 567, 5, 41, 0, 0, 566, 564, 1, 0, 0, 0, 566,
+		567, 1, 0, 0, 0, 567, 568, 1, 0, 0, 0, 568, 569, 3, 152, 76, 0, 569, 570,
+		5, 38, 0, 0, 570, 571, 5, 28, 0, 0, 571, 572, 5, 20, 0, 0, 572, 573, 5,
+		29, 0, 0, 573, 91, 1, 0, 0, 0, 574, 575, 3, 94, 47, 0, 575, 577, 5, 37,
+		0, 0, 576, 578, 3, 44, 22, 0, 577, 576, 1, 0, 0, 0, 577, 578, 1, 0, 0,
+		0, 578, 93, 1, 0, 0, 0, 579, 580, 5, 6, 0, 0, 580, 583, 3, 96, 48, 0, 581,
+		583, 5, 2, 0, 0, 582, 579, 1, 0, 0, 0, 582, 581, 1, 0, 0, 0, 583, 95, 1,
+		0, 0, 0, 584, 587, 3, 114, 57, 0, 585, 587, 5, 26, 0, 0, 586, 584, 1, 0,
+		0, 0, 586, 585, 1, 0, 0, 0, 587, 595, 1, 0, 0, 0, 588, 591, 5, 35, 0, 0,
+		589, 592, 3, 114, 57, 0, 590, 592, 5, 26, 0, 0, 591, 589, 1, 0, 0, 0, 591,
+		590, 1, 0, 0, 0, 592, 594, 1, 0, 0, 0, 593, 588, 1, 0, 0, 0, 594, 597,
+		1, 0, 0, 0, 595, 593, 1, 0, 0, 0, 595, 596, 1, 0, 0, 0, 596, 97, 1, 0,
+		0, 0, 597, 595, 1, 0, 0, 0, 598, 599, 5, 5, 0, 0, 599, 603, 5, 30, 0, 0,
+		600, 602, 3, 100, 50, 0, 601, 600, 1, 0, 0, 0, 602, 605, 1, 0, 0, 0, 603,
+		601, 1, 0, 0, 0, 603, 604, 1, 0, 0, 0, 604, 606, 1, 0, 0, 0, 605, 603,
+		1, 0, 0, 0, 606, 607, 5, 31, 0, 0, 607, 99, 1, 0, 0, 0, 608, 609, 3, 102,
+		51, 0, 609, 611, 5, 37, 0, 0, 610, 612, 3, 44, 22, 0, 611, 610, 1, 0, 0,
+		0, 611, 612, 1, 0, 0, 0, 612, 101, 1, 0, 0, 0, 613, 616, 5, 6, 0, 0, 614,
+		617, 3, 52, 26, 0, 615, 617, 3, 104, 52, 0, 616, 614, 1, 0, 0, 0, 616,
+		615, 1, 0, 0, 0, 617, 620, 1, 0, 0, 0, 618, 620, 5, 2, 0, 0, 619, 613,
+		1, 0, 0, 0, 619, 618, 1, 0, 0, 0, 620, 103, 1, 0, 0, 0, 621, 622, 3, 18,
+		9, 0, 622, 623, 5, 34, 0, 0, 623, 628, 1, 0, 0, 0, 624, 625, 3, 16, 8,
+		0, 625, 626, 5, 41, 0, 0, 626, 628, 1, 0, 0, 0, 627, 621, 1, 0, 0, 0, 627,
+		624, 1, 0, 0, 0, 627, 628, 1, 0, 0, 0, 628, 629, 1, 0, 0, 0, 629, 630,
+		3, 150, 75, 0, 630, 105, 1, 0, 0, 0, 631, 639, 5, 22, 0, 0, 632, 634, 3,
+		150, 75, 0, 633, 632, 1, 0, 0, 0, 633, 634, 1, 0, 0, 0, 634, 640, 1, 0,
+		0, 0, 635, 640, 3, 108, 54, 0, 636, 638, 3, 110, 55, 0, 637, 636, 1, 0,
+		0, 0, 637, 638, 1, 0, 0, 0, 638, 640, 1, 0, 0, 0, 639, 633, 1, 0, 0, 0,
+		639, 635, 1, 0, 0, 0, 639, 637, 1, 0, 0, 0, 640, 641, 1, 0, 0, 0, 641,
+		642, 3, 42, 21, 0, 642, 107, 1, 0, 0, 0, 643, 645, 3, 48, 24, 0, 644, 643,
+		1, 0, 0, 0, 644, 645, 1, 0, 0, 0, 645, 646, 1, 0, 0, 0, 646, 648, 3, 206,
+		103, 0, 647, 649, 3, 150, 75, 0, 648, 647, 1, 0, 0, 0, 648, 649, 1, 0,
+		0, 0, 649, 650, 1, 0, 0, 0, 650, 652, 3, 206, 103, 0, 651, 653, 3, 48,
+		24, 0, 652, 651, 1, 0, 0, 0, 652, 653, 1, 0, 0, 0, 653, 109, 1, 0, 0, 0,
+		654, 655, 3, 18, 9, 0, 655, 656, 5, 34, 0, 0, 656, 661, 1, 0, 0, 0, 657,
+		658, 3, 16, 8, 0, 658, 659, 5, 41, 0, 0, 659, 661, 1, 0, 0, 0, 660, 654,
+		1, 0, 0, 0, 660, 657, 1, 0, 0, 0, 660, 661, 1, 0, 0, 0, 661, 662, 1, 0,
+		0, 0, 662, 663, 5, 19, 0, 0, 663, 664, 3, 150, 75, 0, 664, 111, 1, 0, 0,
+		0, 665, 666, 5, 8, 0, 0, 666, 667, 3, 150, 75, 0, 667, 113, 1, 0, 0, 0,
+		668, 670, 3, 118, 59, 0, 669, 671, 3, 116, 58, 0, 670, 669, 1, 0, 0, 0,
+		670, 671, 1, 0, 0, 0, 671, 678, 1, 0, 0, 0, 672, 678, 3, 120, 60, 0, 673,
+		674, 5, 28, 0, 0, 674, 675, 3, 114, 57, 0, 675, 676, 5, 29, 0, 0, 676,
+		678, 1, 0, 0, 0, 677, 668, 1, 0, 0, 0, 677, 672, 1, 0, 0, 0, 677, 673,
+		1, 0, 0, 0, 678, 115, 1, 0, 0, 0, 679, 680, 5, 32, 0, 0, 680, 682, 3, 96,
+		48, 0, 681, 683, 5, 35, 0, 0, 682, 681, 1, 0, 0, 0, 682, 683, 1, 0, 0,
+		0, 683, 684, 1, 0, 0, 0, 684, 685, 5, 33, 0, 0, 685, 117, 1, 0, 0, 0, 686,
+		689, 3, 168, 84, 0, 687, 689, 5, 27, 0, 0, 688, 686, 1, 0, 0, 0, 688, 687,
+		1, 0, 0, 0, 689, 119, 1, 0, 0, 0, 690, 699, 3, 122, 61, 0, 691, 699, 3,
+		184, 92, 0, 692, 699, 3, 128, 64, 0, 693, 699, 3, 140, 70, 0, 694, 699,
+		3, 130, 65, 0, 695, 699, 3, 132, 66, 0, 696, 699, 3, 134, 67, 0, 697, 699,
+		3, 136, 68, 0, 698, 690, 1, 0, 0, 0, 698, 691, 1, 0, 0, 0, 698, 692, 1,
+		0, 0, 0, 698, 693, 1, 0, 0, 0, 698, 694, 1, 0, 0, 0, 698, 695, 1, 0, 0,
+		0, 698, 696, 1, 0, 0, 0, 698, 697, 1, 0, 0, 0, 699, 121, 1, 0, 0, 0, 700,
+		701, 5, 32, 0, 0, 701, 702, 3, 124, 62, 0, 702, 703, 5, 33, 0, 0, 703,
+		704, 3, 126, 63, 0, 704, 123, 1, 0, 0, 0, 705, 706, 3, 150, 75, 0, 706,
+		125, 1, 0, 0, 0, 707, 708, 3, 114, 57, 0, 708, 127, 1, 0, 0, 0, 709, 710,
+		5, 61, 0, 0, 710, 711, 3, 114, 57, 0, 711, 129, 1, 0, 0, 0, 712, 713, 5,
+		4, 0, 0, 713, 722, 5, 30, 0, 0, 714, 717, 3, 138, 69, 0, 715, 717, 3, 28,
+		14, 0, 716, 714, 1, 0, 0, 0, 716, 715, 1, 0, 0, 0, 717, 718, 1, 0, 0, 0,
+		718, 719, 3, 206, 103, 0, 719, 721, 1, 0, 0, 0, 720, 716, 1, 0, 0, 0, 721,
+		724, 1, 0, 0, 0, 722, 720, 1, 0, 0, 0, 722, 723, 1, 0, 0, 0, 723, 725,
+		1, 0, 0, 0, 724, 722, 1, 0, 0, 0, 725, 726, 5, 31, 0, 0, 726, 131, 1, 0,
+		0, 0, 727, 728, 5, 32, 0, 0, 728, 729, 5, 33, 0, 0, 729, 730, 3, 126, 63,
+		0, 730, 133, 1, 0, 0, 0, 731, 732, 5, 9, 0, 0, 732, 733, 5, 32, 0, 0, 733,
+		734, 3, 114, 57, 0, 734, 735, 5, 33, 0, 0, 735, 736, 3, 126, 63, 0, 736,
+		135, 1, 0, 0, 0, 737, 743, 5, 11, 0, 0, 738, 739, 5, 11, 0, 0, 739, 743,
+		5, 63, 0, 0, 740, 741, 5, 63, 0, 0, 741, 743, 5, 11, 0, 0, 742, 737, 1,
+		0, 0, 0, 742, 738, 1, 0, 0, 0, 742, 740, 1, 0, 0, 0, 743, 744, 1, 0, 0,
+		0, 744, 745, 3, 126, 63, 0, 745, 137, 1, 0, 0, 0, 746, 747, 5, 27, 0, 0,
+		747, 748, 3, 146, 73, 0, 748, 749, 3, 144, 72, 0, 749, 753, 1, 0, 0, 0,
+		750, 751, 5, 27, 0, 0, 751, 753, 3, 146, 73, 0, 752, 746, 1, 0, 0, 0, 752,
+		750, 1, 0, 0, 0, 753, 139, 1, 0, 0, 0, 754, 755, 5, 3, 0, 0, 755, 756,
+		3, 142, 71, 0, 756, 141, 1, 0, 0, 0, 757, 758, 3, 146, 73, 0, 758, 759,
+		3, 144, 72, 0, 759, 762, 1, 0, 0, 0, 760, 762, 3, 146, 73, 0, 761, 757,
+		1, 0, 0, 0, 761, 760, 1, 0, 0, 0, 762, 143, 1, 0, 0, 0, 763, 766, 3, 146,
+		73, 0, 764, 766, 3, 114, 57, 0, 765, 763, 1, 0, 0, 0, 765, 764, 1, 0, 0,
+		0, 766, 145, 1, 0, 0, 0, 767, 779, 5, 28, 0, 0, 768, 773, 3, 148, 74, 0,
+		769, 770, 5, 35, 0, 0, 770, 772, 3, 148, 74, 0, 771, 769, 1, 0, 0, 0, 772,
+		775, 1, 0, 0, 0, 773, 771, 1, 0, 0, 0, 773, 774, 1, 0, 0, 0, 774, 777,
+		1, 0, 0, 0, 775, 773, 1, 0, 0, 0, 776, 778, 5, 35, 0, 0, 777, 776, 1, 0,
+		0, 0, 777, 778, 1, 0, 0, 0, 778, 780, 1, 0, 0, 0, 779, 768, 1, 0, 0, 0,
+		779, 780, 1, 0, 0, 0, 780, 781, 1, 0, 0, 0, 781, 782, 5, 29, 0, 0, 782,
+		147, 1, 0, 0, 0, 783, 785, 3, 16, 8, 0, 784, 783, 1, 0, 0, 0, 784, 785,
+		1, 0, 0, 0, 785, 787, 1, 0, 0, 0, 786, 788, 5, 42, 0, 0, 787, 786, 1, 0,
+		0, 0, 787, 788, 1, 0, 0, 0, 788, 789, 1, 0, 0, 0, 789, 790, 3, 114, 57,
+		0, 790, 149, 1, 0, 0, 0, 791, 792, 6, 70, -1, 0, 792, 796, 3, 152, 76,
+		0, 793, 794, 7, 4, 0, 0, 794, 796, 3, 150, 75, 6, 795, 791, 1, 0, 0, 0,
+		795, 793, 1, 0, 0, 0, 796, 814, 1, 0, 0, 0, 797, 798, 10, 5, 0, 0, 798,
+		799, 7, 5, 0, 0, 799, 813, 3, 150, 75, 6, 800, 801, 10, 4, 0, 0, 801, 802,
+		7, 6, 0, 0, 802, 813, 3, 150, 75, 5, 803, 804, 10, 3, 0, 0, 804, 805, 7,
+		7, 0, 0, 805, 813, 3, 150, 75, 4, 806, 807, 10, 2, 0, 0, 807, 808, 5, 44,
+		0, 0, 808, 813, 3, 150, 75, 3, 809, 810, 10, 1, 0, 0, 810, 811, 5, 43,
+		0, 0, 811, 813, 3, 150, 75, 2, 812, 797, 1, 0, 0, 0, 812, 800, 1, 0, 0,
+		0, 812, 803, 1, 0, 0, 0, 812, 806, 1, 0, 0, 0, 812, 809, 1, 0, 0, 0, 813,
+		816, 1, 0, 0, 0, 814, 812, 1, 0, 0, 0, 814, 815, 1, 0, 0, 0, 815, 151,
+		1, 0, 0, 0, 816, 814, 1, 0, 0, 0, 817, 818, 6, 71, -1, 0, 818, 822, 3,
+		158, 79, 0, 819, 822, 3, 154, 77, 0, 820, 822, 3, 202, 101, 0, 821, 817,
+		1, 0, 0, 0, 821, 819, 1, 0, 0, 0, 821, 820, 1, 0, 0, 0, 822, 834, 1, 0,
+		0, 0, 823, 830, 10, 1, 0, 0, 824, 825, 5, 38, 0, 0, 825, 831, 5, 27, 0,
+		0, 826, 831, 3, 194, 97, 0, 827, 831, 3, 196, 98, 0, 828, 831, 3, 198,
+		99, 0, 829, 831, 3, 200, 100, 0, 830, 824, 1, 0, 0, 0, 830, 826, 1, 0,
+		0, 0, 830, 827, 1, 0, 0, 0, 830, 828, 1, 0, 0, 0, 830, 829, 1, 0, 0, 0,
+		831, 833, 1, 0, 0, 0, 832, 823, 1, 0, 0, 0, 833, 836, 1, 0, 0, 0, 834,
+		832, 1, 0, 0, 0, 834, 835, 1, 0, 0, 0, 835, 153, 1, 0, 0, 0, 836, 834,
+		1, 0, 0, 0, 837, 838, 3, 156, 78, 0, 838, 839, 5, 28, 0, 0, 839, 841, 3,
+		150, 75, 0, 840, 842, 5, 35, 0, 0, 841, 840, 1, 0, 0, 0, 841, 842, 1, 0,
+		0, 0, 842, 843, 1, 0, 0, 0, 843, 844, 5, 29, 0, 0, 844, 155, 1, 0, 0, 0,
+		845, 851, 3, 120, 60, 0, 846, 847, 5, 28, 0, 0, 847, 848, 3, 156, 78, 0,
+		848, 849, 5, 29, 0, 0, 849, 851, 1, 0, 0, 0, 850, 845, 1, 0, 0, 0, 850,
+		846, 1, 0, 0, 0, 851, 157, 1, 0, 0, 0, 852, 862, 3, 160, 80, 0, 853, 855,
+		3, 166, 83, 0, 854, 856, 3, 116, 58, 0, 855, 854, 1, 0, 0, 0, 855, 856,
+		1, 0, 0, 0, 856, 862, 1, 0, 0, 0, 857, 858, 5, 28, 0, 0, 858, 859, 3, 150,
+		75, 0, 859, 860, 5, 29, 0, 0, 860, 862, 1, 0, 0, 0, 861, 852, 1, 0, 0,
+		0, 861, 853, 1, 0, 0, 0, 861, 857, 1, 0, 0, 0, 862, 159, 1, 0, 0, 0, 863,
+		867, 3, 162, 81, 0, 864, 867, 3, 170, 85, 0, 865, 867, 3, 192, 96, 0, 866,
+		863, 1, 0, 0, 0, 866, 864, 1, 0, 0, 0, 866, 865, 1, 0, 0, 0, 867, 161,
+		1, 0, 0, 0, 868, 873, 5, 26, 0, 0, 869, 873, 3, 164, 82, 0, 870, 873, 3,
+		188, 94, 0, 871, 873, 5, 69, 0, 0, 872, 868, 1, 0, 0, 0, 872, 869, 1, 0,
+		0, 0, 872, 870, 1, 0, 0, 0, 872, 871, 1, 0, 0, 0, 873, 163, 1, 0, 0, 0,
+		874, 875, 7, 8, 0, 0, 875, 165, 1, 0, 0, 0, 876, 877, 5, 27, 0, 0, 877,
+		167, 1, 0, 0, 0, 878, 879, 5, 27, 0, 0, 879, 880, 5, 38, 0, 0, 880, 881,
+		5, 27, 0, 0, 881, 169, 1, 0, 0, 0, 882, 883, 3, 172, 86, 0, 883, 884, 3,
+		174, 87, 0, 884, 171, 1, 0, 0, 0, 885, 898, 3, 184, 92, 0, 886, 898, 3,
+		122, 61, 0, 887, 888, 5, 32, 0, 0, 888, 889, 5, 42, 0, 0, 889, 890, 5,
+		33, 0, 0, 890, 898, 3, 126, 63, 0, 891, 898, 3, 132, 66, 0, 892, 898, 3,
+		134, 67, 0, 893, 895, 3, 118, 59, 0, 894, 896, 3, 116, 58, 0, 895, 894,
+		1, 0, 0, 0, 895, 896, 1, 0, 0, 0, 896, 898, 1, 0, 0, 0, 897, 885, 1, 0,
+		0, 0, 897, 886, 1, 0, 0, 0, 897, 887, 1, 0, 0, 0, 897, 891, 1, 0, 0, 0,
+		897, 892, 1, 0, 0, 0, 897, 893, 1, 0, 0, 0, 898, 173, 1, 0, 0, 0, 899,
+		904, 5, 30, 0, 0, 900, 902, 3, 176, 88, 0, 901, 903, 5, 35, 0, 0, 902,
+		901, 1, 0, 0, 0, 902, 903, 1, 0, 0, 0, 903, 905, 1, 0, 0, 0, 904, 900,
+		1, 0, 0, 0, 904, 905, 1, 0, 0, 0, 905, 906, 1, 0, 0, 0, 906, 907, 5, 31,
+		0, 0, 907, 175, 1, 0, 0, 0, 908, 913, 3, 178, 89, 0, 909, 910, 5, 35, 0,
+		0, 910, 912, 3, 178, 89, 0, 911, 909, 1, 0, 0, 0, 912, 915, 1, 0, 0, 0,
+		913, 911, 1, 0, 0, 0, 913, 914, 1, 0, 0, 0, 914, 177, 1, 0, 0, 0, 915,
+		913, 1, 0, 0, 0, 916, 917, 3, 180, 90, 0, 917, 918, 5, 37, 0, 0, 918, 920,
+		1, 0, 0, 0, 919, 916, 1, 0, 0, 0, 919, 920, 1, 0, 0, 0, 920, 921, 1, 0,
+		0, 0, 921, 922, 3, 182, 91, 0, 922, 179, 1, 0, 0, 0, 923, 926, 3, 150,
+		75, 0, 924, 926, 3, 174, 87, 0, 925, 923, 1, 0, 0, 0, 925, 924, 1, 0, 0,
+		0, 926, 181, 1, 0, 0, 0, 927, 930, 3, 150, 75, 0, 928, 930, 3, 174, 87,
+		0, 929, 927, 1, 0, 0, 0, 929, 928, 1, 0, 0, 0, 930, 183, 1, 0, 0, 0, 931,
+		932, 5, 10, 0, 0, 932, 938, 5, 30, 0, 0, 933, 934, 3, 186, 93, 0, 934,
+		935, 3, 206, 103, 0, 935, 937, 1, 0, 0, 0, 936, 933, 1, 0, 0, 0, 937, 940,
+		1, 0, 0, 0, 938, 936, 1, 0, 0, 0, 938, 939, 1, 0, 0, 0, 939, 941, 1, 0,
+		0, 0, 940, 938, 1, 0, 0, 0, 941, 942, 5, 31, 0, 0, 942, 185, 1, 0, 0, 0,
+		943, 944, 3, 16, 8, 0, 944, 945, 3, 114, 57, 0, 945, 948, 1, 0, 0, 0, 946,
+		948, 3, 190, 95, 0, 947, 943, 1, 0, 0, 0, 947, 946, 1, 0, 0, 0, 948, 950,
+		1, 0, 0, 0, 949, 951, 3, 188, 94, 0, 950, 949, 1, 0, 0, 0, 950, 951, 1,
+		0, 0, 0, 951, 187, 1, 0, 0, 0, 952, 953, 7, 9, 0, 0, 953, 189, 1, 0, 0,
+		0, 954, 956, 5, 61, 0, 0, 955, 954, 1, 0, 0, 0, 955, 956, 1, 0, 0, 0, 956,
+		957, 1, 0, 0, 0, 957, 959, 3, 118, 59, 0, 958, 960, 3, 116, 58, 0, 959,
+		958, 1, 0, 0, 0, 959, 960, 1, 0, 0, 0, 960, 191, 1, 0, 0, 0, 961, 962,
+		5, 3, 0, 0, 962, 963, 3, 142, 71, 0, 963, 964, 3, 42, 21, 0, 964, 193,
+		1, 0, 0, 0, 965, 966, 5, 32, 0, 0, 966, 967, 3, 150, 75, 0, 967, 968, 5,
+		33, 0, 0, 968, 195, 1, 0, 0, 0, 969, 985, 5, 32, 0, 0, 970, 972, 3, 150,
+		75, 0, 971, 970, 1, 0, 0, 0, 971, 972, 1, 0, 0, 0, 972, 973, 1, 0, 0, 0,
+		973, 975, 5, 37, 0, 0, 974, 976, 3, 150, 75, 0, 975, 974, 1, 0, 0, 0, 975,
+		976, 1, 0, 0, 0, 976, 986, 1, 0, 0, 0, 977, 979, 3, 150, 75, 0, 978, 977,
+		1, 0, 0, 0, 978, 979, 1, 0, 0, 0, 979, 980, 1, 0, 0, 0, 980, 981, 5, 37,
+		0, 0, 981, 982, 3, 150, 75, 0, 982, 983, 5, 37, 0, 0, 983, 984, 3, 150,
+		75, 0, 984, 986, 1, 0, 0, 0, 985, 971, 1, 0, 0, 0, 985, 978, 1, 0, 0, 0,
+		986, 987, 1, 0, 0, 0, 987, 988, 5, 33, 0, 0, 988, 197, 1, 0, 0, 0, 989,
+		990, 5, 38, 0, 0, 990, 991, 5, 28, 0, 0, 991, 992, 3, 114, 57, 0, 992,
+		993, 5, 29, 0, 0, 993, 199, 1, 0, 0, 0, 994, 1009, 5, 28, 0, 0, 995, 1002,
+		3, 18, 9, 0, 996, 999, 3, 156, 78, 0, 997, 998, 5, 35, 0, 0, 998, 1000,
+		3, 18, 9, 0, 999, 997, 1, 0, 0, 0, 999, 1000, 1, 0, 0, 0, 1000, 1002, 1,
+		0, 0, 0, 1001, 995, 1, 0, 0, 0, 1001, 996, 1, 0, 0, 0, 1002, 1004, 1, 0,
+		0, 0, 1003, 1005, 5, 42, 0, 0, 1004, 1003, 1, 0, 0, 0, 1004, 1005, 1, 0,
+		0, 0, 1005, 1007, 1, 0, 0, 0, 1006, 1008, 5, 35, 0, 0, 1007, 1006, 1, 0,
+		0, 0, 1007, 1008, 1, 0, 0, 0, 1008, 1010, 1, 0, 0, 0, 1009, 1001, 1, 0,
+		0, 0, 1009, 1010, 1, 0, 0, 0, 1010, 1011, 1, 0, 0, 0, 1011, 1012, 5, 29,
+		0, 0, 1012, 201, 1, 0, 0, 0, 1013, 1014, 3, 156, 78, 0, 1014, 1015, 5,
+		38, 0, 0, 1015, 1016, 5, 27, 0, 0, 1016, 203, 1, 0, 0, 0, 1017, 1018, 3,
+		114, 57, 0, 1018, 205, 1, 0, 0, 0, 1019, 1024, 5, 36, 0, 0, 1020, 1024,
+		5, 0, 0, 1, 1021, 1024, 5, 88, 0, 0, 1022, 1024, 4, 103, 7, 0, 1023, 1019,
+		1, 0, 0, 0, 1023, 1020, 1, 0, 0, 0, 1023, 1021, 1, 0, 0, 0, 1023, 1022,
+		1, 0, 0, 0, 1024, 207, 1, 0, 0, 0, 122, 215, 221, 227, 243, 247, 250, 259,
+		269, 273, 277, 281, 288, 296, 307, 311, 315, 318, 328, 332, 344, 348, 355,
+		359, 366, 378, 382, 388, 392, 396, 401, 404, 407, 414, 431, 438, 454, 467,
+		471, 475, 479, 498, 504, 506, 510, 514, 517, 521, 523, 529, 537, 542, 553,
+		559, 566, 577, 582, 586, 591, 595, 603, 611, 616, 619, 627, 633, 637, 639,
+		644, 648, 652, 660, 670, 677, 682, 688, 698, 716, 722, 742, 752, 761, 765,
+		773, 777, 779, 784, 787, 795, 812, 814, 821, 830, 834, 841, 850, 855, 861,
+		866, 872, 895, 897, 902, 904, 913, 919, 925, 929, 938, 947, 950, 955, 959,
+		971, 975, 978, 985, 999, 1001, 1004, 1007, 1009, 1023,
 	}
 	deserializer := antlr.NewATNDeserializer(nil)
 	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
@@ -619,134 +652,140 @@ const (
 	GoParserSTAR                   = 61
 	GoParserAMPERSAND              = 62
 	GoParserRECEIVE                = 63
-	GoParserDECIMAL_LIT            = 64
-	GoParserBINARY_LIT             = 65
-	GoParserOCTAL_LIT              = 66
-	GoParserHEX_LIT                = 67
-	GoParserFLOAT_LIT              = 68
-	GoParserDECIMAL_FLOAT_LIT      = 69
-	GoParserHEX_FLOAT_LIT          = 70
-	GoParserIMAGINARY_LIT          = 71
-	GoParserRUNE_LIT               = 72
-	GoParserBYTE_VALUE             = 73
-	GoParserOCTAL_BYTE_VALUE       = 74
-	GoParserHEX_BYTE_VALUE         = 75
-	GoParserLITTLE_U_VALUE         = 76
-	GoParserBIG_U_VALUE            = 77
-	GoParserRAW_STRING_LIT         = 78
-	GoParserINTERPRETED_STRING_LIT = 79
-	GoParserWS                     = 80
-	GoParserCOMMENT                = 81
-	GoParserTERMINATOR             = 82
-	GoParserLINE_COMMENT           = 83
-	GoParserWS_NLSEMI              = 84
-	GoParserCOMMENT_NLSEMI         = 85
-	GoParserLINE_COMMENT_NLSEMI    = 86
-	GoParserEOS                    = 87
-	GoParserOTHER                  = 88
+	GoParserUNDERLYING             = 64
+	GoParserDECIMAL_LIT            = 65
+	GoParserBINARY_LIT             = 66
+	GoParserOCTAL_LIT              = 67
+	GoParserHEX_LIT                = 68
+	GoParserFLOAT_LIT              = 69
+	GoParserDECIMAL_FLOAT_LIT      = 70
+	GoParserHEX_FLOAT_LIT          = 71
+	GoParserIMAGINARY_LIT          = 72
+	GoParserRUNE_LIT               = 73
+	GoParserBYTE_VALUE             = 74
+	GoParserOCTAL_BYTE_VALUE       = 75
+	GoParserHEX_BYTE_VALUE         = 76
+	GoParserLITTLE_U_VALUE         = 77
+	GoParserBIG_U_VALUE            = 78
+	GoParserRAW_STRING_LIT         = 79
+	GoParserINTERPRETED_STRING_LIT = 80
+	GoParserWS                     = 81
+	GoParserCOMMENT                = 82
+	GoParserTERMINATOR             = 83
+	GoParserLINE_COMMENT           = 84
+	GoParserWS_NLSEMI              = 85
+	GoParserCOMMENT_NLSEMI         = 86
+	GoParserLINE_COMMENT_NLSEMI    = 87
+	GoParserEOS                    = 88
+	GoParserOTHER                  = 89
 )
 
 // GoParser rules.
 const (
-	GoParserRULE_sourceFile      = 0
-	GoParserRULE_packageClause   = 1
-	GoParserRULE_importDecl      = 2
-	GoParserRULE_importSpec      = 3
-	GoParserRULE_importPath      = 4
-	GoParserRULE_declaration     = 5
-	GoParserRULE_constDecl       = 6
-	GoParserRULE_constSpec       = 7
-	GoParserRULE_identifierList  = 8
-	GoParserRULE_expressionList  = 9
-	GoParserRULE_typeDecl        = 10
-	GoParserRULE_typeSpec        = 11
-	GoParserRULE_functionDecl    = 12
-	GoParserRULE_methodDecl      = 13
-	GoParserRULE_receiver        = 14
-	GoParserRULE_varDecl         = 15
-	GoParserRULE_varSpec         = 16
-	GoParserRULE_block           = 17
-	GoParserRULE_statementList   = 18
-	GoParserRULE_statement       = 19
-	GoParserRULE_simpleStmt      = 20
-	GoParserRULE_expressionStmt  = 21
-	GoParserRULE_sendStmt        = 22
-	GoParserRULE_incDecStmt      = 23
-	GoParserRULE_assignment      = 24
-	GoParserRULE_assign_op       = 25
-	GoParserRULE_shortVarDecl    = 26
-	GoParserRULE_emptyStmt       = 27
-	GoParserRULE_labeledStmt     = 28
-	GoParserRULE_returnStmt      = 29
-	GoParserRULE_breakStmt       = 30
-	GoParserRULE_continueStmt    = 31
-	GoParserRULE_gotoStmt        = 32
-	GoParserRULE_fallthroughStmt = 33
-	GoParserRULE_deferStmt       = 34
-	GoParserRULE_ifStmt          = 35
-	GoParserRULE_switchStmt      = 36
-	GoParserRULE_exprSwitchStmt  = 37
-	GoParserRULE_exprCaseClause  = 38
-	GoParserRULE_exprSwitchCase  = 39
-	GoParserRULE_typeSwitchStmt  = 40
-	GoParserRULE_typeSwitchGuard = 41
-	GoParserRULE_typeCaseClause  = 42
-	GoParserRULE_typeSwitchCase  = 43
-	GoParserRULE_typeList        = 44
-	GoParserRULE_selectStmt      = 45
-	GoParserRULE_commClause      = 46
-	GoParserRULE_commCase        = 47
-	GoParserRULE_recvStmt        = 48
-	GoParserRULE_forStmt         = 49
-	GoParserRULE_forClause       = 50
-	GoParserRULE_rangeClause     = 51
-	GoParserRULE_goStmt          = 52
-	GoParserRULE_type_           = 53
-	GoParserRULE_typeName        = 54
-	GoParserRULE_typeLit         = 55
-	GoParserRULE_arrayType       = 56
-	GoParserRULE_arrayLength     = 57
-	GoParserRULE_elementType     = 58
-	GoParserRULE_pointerType     = 59
-	GoParserRULE_interfaceType   = 60
-	GoParserRULE_sliceType       = 61
-	GoParserRULE_mapType         = 62
-	GoParserRULE_channelType     = 63
-	GoParserRULE_methodSpec      = 64
-	GoParserRULE_functionType    = 65
-	GoParserRULE_signature       = 66
-	GoParserRULE_result          = 67
-	GoParserRULE_parameters      = 68
-	GoParserRULE_parameterDecl   = 69
-	GoParserRULE_expression      = 70
-	GoParserRULE_primaryExpr     = 71
-	GoParserRULE_conversion      = 72
-	GoParserRULE_nonNamedType    = 73
-	GoParserRULE_operand         = 74
-	GoParserRULE_literal         = 75
-	GoParserRULE_basicLit        = 76
-	GoParserRULE_integer         = 77
-	GoParserRULE_operandName     = 78
-	GoParserRULE_qualifiedIdent  = 79
-	GoParserRULE_compositeLit    = 80
-	GoParserRULE_literalType     = 81
-	GoParserRULE_literalValue    = 82
-	GoParserRULE_elementList     = 83
-	GoParserRULE_keyedElement    = 84
-	GoParserRULE_key             = 85
-	GoParserRULE_element         = 86
-	GoParserRULE_structType      = 87
-	GoParserRULE_fieldDecl       = 88
-	GoParserRULE_string_         = 89
-	GoParserRULE_embeddedField   = 90
-	GoParserRULE_functionLit     = 91
-	GoParserRULE_index           = 92
-	GoParserRULE_slice_          = 93
-	GoParserRULE_typeAssertion   = 94
-	GoParserRULE_arguments       = 95
-	GoParserRULE_methodExpr      = 96
-	GoParserRULE_receiverType    = 97
-	GoParserRULE_eos             = 98
+	GoParserRULE_sourceFile        = 0
+	GoParserRULE_packageClause     = 1
+	GoParserRULE_importDecl        = 2
+	GoParserRULE_importSpec        = 3
+	GoParserRULE_importPath        = 4
+	GoParserRULE_declaration       = 5
+	GoParserRULE_constDecl         = 6
+	GoParserRULE_constSpec         = 7
+	GoParserRULE_identifierList    = 8
+	GoParserRULE_expressionList    = 9
+	GoParserRULE_typeDecl          = 10
+	GoParserRULE_typeSpec          = 11
+	GoParserRULE_typeParameters    = 12
+	GoParserRULE_typeParameterDecl = 13
+	GoParserRULE_typeElement       = 14
+	GoParserRULE_typeTerm          = 15
+	GoParserRULE_functionDecl      = 16
+	GoParserRULE_methodDecl        = 17
+	GoParserRULE_receiver          = 18
+	GoParserRULE_varDecl           = 19
+	GoParserRULE_varSpec           = 20
+	GoParserRULE_block             = 21
+	GoParserRULE_statementList     = 22
+	GoParserRULE_statement         = 23
+	GoParserRULE_simpleStmt        = 24
+	GoParserRULE_expressionStmt    = 25
+	GoParserRULE_sendStmt          = 26
+	GoParserRULE_incDecStmt        = 27
+	GoParserRULE_assignment        = 28
+	GoParserRULE_assign_op         = 29
+	GoParserRULE_shortVarDecl      = 30
+	GoParserRULE_emptyStmt         = 31
+	GoParserRULE_labeledStmt       = 32
+	GoParserRULE_returnStmt        = 33
+	GoParserRULE_breakStmt         = 34
+	GoParserRULE_continueStmt      = 35
+	GoParserRULE_gotoStmt          = 36
+	GoParserRULE_fallthroughStmt   = 37
+	GoParserRULE_deferStmt         = 38
+	GoParserRULE_ifStmt            = 39
+	GoParserRULE_switchStmt        = 40
+	GoParserRULE_exprSwitchStmt    = 41
+	GoParserRULE_exprCaseClause    = 42
+	GoParserRULE_exprSwitchCase    = 43
+	GoParserRULE_typeSwitchStmt    = 44
+	GoParserRULE_typeSwitchGuard   = 45
+	GoParserRULE_typeCaseClause    = 46
+	GoParserRULE_typeSwitchCase    = 47
+	GoParserRULE_typeList          = 48
+	GoParserRULE_selectStmt        = 49
+	GoParserRULE_commClause        = 50
+	GoParserRULE_commCase          = 51
+	GoParserRULE_recvStmt          = 52
+	GoParserRULE_forStmt           = 53
+	GoParserRULE_forClause         = 54
+	GoParserRULE_rangeClause       = 55
+	GoParserRULE_goStmt            = 56
+	GoParserRULE_type_             = 57
+	GoParserRULE_typeArgs          = 58
+	GoParserRULE_typeName          = 59
+	GoParserRULE_typeLit           = 60
+	GoParserRULE_arrayType         = 61
+	GoParserRULE_arrayLength       = 62
+	GoParserRULE_elementType       = 63
+	GoParserRULE_pointerType       = 64
+	GoParserRULE_interfaceType     = 65
+	GoParserRULE_sliceType         = 66
+	GoParserRULE_mapType           = 67
+	GoParserRULE_channelType       = 68
+	GoParserRULE_methodSpec        = 69
+	GoParserRULE_functionType      = 70
+	GoParserRULE_signature         = 71
+	GoParserRULE_result            = 72
+	GoParserRULE_parameters        = 73
+	GoParserRULE_parameterDecl     = 74
+	GoParserRULE_expression        = 75
+	GoParserRULE_primaryExpr       = 76
+	GoParserRULE_conversion        = 77
+	GoParserRULE_nonNamedType      = 78
+	GoParserRULE_operand           = 79
+	GoParserRULE_literal           = 80
+	GoParserRULE_basicLit          = 81
+	GoParserRULE_integer           = 82
+	GoParserRULE_operandName       = 83
+	GoParserRULE_qualifiedIdent    = 84
+	GoParserRULE_compositeLit      = 85
+	GoParserRULE_literalType       = 86
+	GoParserRULE_literalValue      = 87
+	GoParserRULE_elementList       = 88
+	GoParserRULE_keyedElement      = 89
+	GoParserRULE_key               = 90
+	GoParserRULE_element           = 91
+	GoParserRULE_structType        = 92
+	GoParserRULE_fieldDecl         = 93
+	GoParserRULE_string_           = 94
+	GoParserRULE_embeddedField     = 95
+	GoParserRULE_functionLit       = 96
+	GoParserRULE_index             = 97
+	GoParserRULE_slice_            = 98
+	GoParserRULE_typeAssertion     = 99
+	GoParserRULE_arguments         = 100
+	GoParserRULE_methodExpr        = 101
+	GoParserRULE_receiverType      = 102
+	GoParserRULE_eos               = 103
 )
 
 // ISourceFileContext is an interface to support dynamic dispatch.
@@ -1058,69 +1097,69 @@ func (p *GoParser) SourceFile() (localctx ISourceFileContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(198)
+		p.SetState(208)
 		p.PackageClause()
 	}
 	{
-		p.SetState(199)
+		p.SetState(209)
 		p.Eos()
 	}
-	p.SetState(205)
+	p.SetState(215)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == GoParserIMPORT {
 		{
-			p.SetState(200)
+			p.SetState(210)
 			p.ImportDecl()
 		}
 		{
-			p.SetState(201)
+			p.SetState(211)
 			p.Eos()
 		}
 
-		p.SetState(207)
+		p.SetState(217)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
-	p.SetState(217)
+	p.SetState(227)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<GoParserFUNC)|(1<<GoParserCONST)|(1<<GoParserTYPE)|(1<<GoParserVAR))) != 0 {
-		p.SetState(211)
+		p.SetState(221)
 		p.GetErrorHandler().Sync(p)
 		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 1, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(208)
+				p.SetState(218)
 				p.FunctionDecl()
 			}
 
 		case 2:
 			{
-				p.SetState(209)
+				p.SetState(219)
 				p.MethodDecl()
 			}
 
 		case 3:
 			{
-				p.SetState(210)
+				p.SetState(220)
 				p.Declaration()
 			}
 
 		}
 		{
-			p.SetState(213)
+			p.SetState(223)
 			p.Eos()
 		}
 
-		p.SetState(219)
+		p.SetState(229)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(220)
+		p.SetState(230)
 		p.Match(GoParserEOF)
 	}
 
@@ -1229,11 +1268,11 @@ func (p *GoParser) PackageClause() (localctx IPackageClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(222)
+		p.SetState(232)
 		p.Match(GoParserPACKAGE)
 	}
 	{
-		p.SetState(223)
+		p.SetState(233)
 
 		var _m = p.Match(GoParserIDENTIFIER)
 
@@ -1421,44 +1460,44 @@ func (p *GoParser) ImportDecl() (localctx IImportDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(225)
+		p.SetState(235)
 		p.Match(GoParserIMPORT)
 	}
-	p.SetState(237)
+	p.SetState(247)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserIDENTIFIER, GoParserDOT, GoParserRAW_STRING_LIT, GoParserINTERPRETED_STRING_LIT:
 		{
-			p.SetState(226)
+			p.SetState(236)
 			p.ImportSpec()
 		}
 
 	case GoParserL_PAREN:
 		{
-			p.SetState(227)
+			p.SetState(237)
 			p.Match(GoParserL_PAREN)
 		}
-		p.SetState(233)
+		p.SetState(243)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == GoParserIDENTIFIER || _la == GoParserDOT || _la == GoParserRAW_STRING_LIT || _la == GoParserINTERPRETED_STRING_LIT {
 			{
-				p.SetState(228)
+				p.SetState(238)
 				p.ImportSpec()
 			}
 			{
-				p.SetState(229)
+				p.SetState(239)
 				p.Eos()
 			}
 
-			p.SetState(235)
+			p.SetState(245)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(236)
+			p.SetState(246)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -1587,13 +1626,13 @@ func (p *GoParser) ImportSpec() (localctx IImportSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(240)
+	p.SetState(250)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == GoParserIDENTIFIER || _la == GoParserDOT {
 		{
-			p.SetState(239)
+			p.SetState(249)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -1613,7 +1652,7 @@ func (p *GoParser) ImportSpec() (localctx IImportSpecContext) {
 
 	}
 	{
-		p.SetState(242)
+		p.SetState(252)
 		p.ImportPath()
 	}
 
@@ -1719,7 +1758,7 @@ func (p *GoParser) ImportPath() (localctx IImportPathContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(244)
+		p.SetState(254)
 		p.String_()
 	}
 
@@ -1855,28 +1894,28 @@ func (p *GoParser) Declaration() (localctx IDeclarationContext) {
 		}
 	}()
 
-	p.SetState(249)
+	p.SetState(259)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserCONST:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(246)
+			p.SetState(256)
 			p.ConstDecl()
 		}
 
 	case GoParserTYPE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(247)
+			p.SetState(257)
 			p.TypeDecl()
 		}
 
 	case GoParserVAR:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(248)
+			p.SetState(258)
 			p.VarDecl()
 		}
 
@@ -2065,44 +2104,44 @@ func (p *GoParser) ConstDecl() (localctx IConstDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(251)
+		p.SetState(261)
 		p.Match(GoParserCONST)
 	}
-	p.SetState(263)
+	p.SetState(273)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserIDENTIFIER:
 		{
-			p.SetState(252)
+			p.SetState(262)
 			p.ConstSpec()
 		}
 
 	case GoParserL_PAREN:
 		{
-			p.SetState(253)
+			p.SetState(263)
 			p.Match(GoParserL_PAREN)
 		}
-		p.SetState(259)
+		p.SetState(269)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == GoParserIDENTIFIER {
 			{
-				p.SetState(254)
+				p.SetState(264)
 				p.ConstSpec()
 			}
 			{
-				p.SetState(255)
+				p.SetState(265)
 				p.Eos()
 			}
 
-			p.SetState(261)
+			p.SetState(271)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(262)
+			p.SetState(272)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -2249,30 +2288,30 @@ func (p *GoParser) ConstSpec() (localctx IConstSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(265)
+		p.SetState(275)
 		p.IdentifierList()
 	}
-	p.SetState(271)
+	p.SetState(281)
 	p.GetErrorHandler().Sync(p)
 
 	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 10, p.GetParserRuleContext()) == 1 {
-		p.SetState(267)
+		p.SetState(277)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<GoParserFUNC)|(1<<GoParserINTERFACE)|(1<<GoParserMAP)|(1<<GoParserSTRUCT)|(1<<GoParserCHAN)|(1<<GoParserIDENTIFIER)|(1<<GoParserL_PAREN))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(GoParserL_BRACKET-32))|(1<<(GoParserSTAR-32))|(1<<(GoParserRECEIVE-32)))) != 0) {
 			{
-				p.SetState(266)
+				p.SetState(276)
 				p.Type_()
 			}
 
 		}
 		{
-			p.SetState(269)
+			p.SetState(279)
 			p.Match(GoParserASSIGN)
 		}
 		{
-			p.SetState(270)
+			p.SetState(280)
 			p.ExpressionList()
 		}
 
@@ -2382,26 +2421,26 @@ func (p *GoParser) IdentifierList() (localctx IIdentifierListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(273)
+		p.SetState(283)
 		p.Match(GoParserIDENTIFIER)
 	}
-	p.SetState(278)
+	p.SetState(288)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(274)
+				p.SetState(284)
 				p.Match(GoParserCOMMA)
 			}
 			{
-				p.SetState(275)
+				p.SetState(285)
 				p.Match(GoParserIDENTIFIER)
 			}
 
 		}
-		p.SetState(280)
+		p.SetState(290)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 11, p.GetParserRuleContext())
 	}
@@ -2543,26 +2582,26 @@ func (p *GoParser) ExpressionList() (localctx IExpressionListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(281)
+		p.SetState(291)
 		p.expression(0)
 	}
-	p.SetState(286)
+	p.SetState(296)
 	p.GetErrorHandler().Sync(p)
 	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(282)
+				p.SetState(292)
 				p.Match(GoParserCOMMA)
 			}
 			{
-				p.SetState(283)
+				p.SetState(293)
 				p.expression(0)
 			}
 
 		}
-		p.SetState(288)
+		p.SetState(298)
 		p.GetErrorHandler().Sync(p)
 		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 12, p.GetParserRuleContext())
 	}
@@ -2650,31 +2689,675 @@ func (s *TypeDeclContext) TypeSpec(i int) ITypeSpecContext {
 		return nil
 	}
 
-	return t.(ITypeSpecContext)
+	return t.(ITypeSpecContext)
+}
+
+func (s *TypeDeclContext) L_PAREN() antlr.TerminalNode {
+	return s.GetToken(GoParserL_PAREN, 0)
+}
+
+func (s *TypeDeclContext) R_PAREN() antlr.TerminalNode {
+	return s.GetToken(GoParserR_PAREN, 0)
+}
+
+func (s *TypeDeclContext) AllEos() []IEosContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IEosContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IEosContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IEosContext); ok {
+			tst[i] = t.(IEosContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TypeDeclContext) Eos(i int) IEosContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IEosContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IEosContext)
+}
+
+func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.EnterTypeDecl(s)
+	}
+}
+
+func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.ExitTypeDecl(s)
+	}
+}
+
+func (p *GoParser) TypeDecl() (localctx ITypeDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, GoParserRULE_typeDecl)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(299)
+		p.Match(GoParserTYPE)
+	}
+	p.SetState(311)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case GoParserIDENTIFIER:
+		{
+			p.SetState(300)
+			p.TypeSpec()
+		}
+
+	case GoParserL_PAREN:
+		{
+			p.SetState(301)
+			p.Match(GoParserL_PAREN)
+		}
+		p.SetState(307)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == GoParserIDENTIFIER {
+			{
+				p.SetState(302)
+				p.TypeSpec()
+			}
+			{
+				p.SetState(303)
+				p.Eos()
+			}
+
+			p.SetState(309)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(310)
+			p.Match(GoParserR_PAREN)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ITypeSpecContext is an interface to support dynamic dispatch.
+type ITypeSpecContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeSpecContext differentiates from other interfaces.
+	IsTypeSpecContext()
+}
+
+type TypeSpecContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeSpecContext() *TypeSpecContext {
+	var p = new(TypeSpecContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = GoParserRULE_typeSpec
+	return p
+}
+
+func (*TypeSpecContext) IsTypeSpecContext() {}
+
+func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
+	var p = new(TypeSpecContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = GoParserRULE_typeSpec
+
+	return p
+}
+
+func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeSpecContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(GoParserIDENTIFIER, 0)
+}
+
+func (s *TypeSpecContext) Type_() IType_Context {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IType_Context); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IType_Context)
+}
+
+func (s *TypeSpecContext) TypeParameters() ITypeParametersContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeParametersContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeParametersContext)
+}
+
+func (s *TypeSpecContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(GoParserASSIGN, 0)
+}
+
+func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.EnterTypeSpec(s)
+	}
+}
+
+func (s *TypeSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.ExitTypeSpec(s)
+	}
+}
+
+func (p *GoParser) TypeSpec() (localctx ITypeSpecContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, GoParserRULE_typeSpec)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(313)
+		p.Match(GoParserIDENTIFIER)
+	}
+	p.SetState(315)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 15, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(314)
+			p.TypeParameters()
+		}
+
+	}
+	p.SetState(318)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == GoParserASSIGN {
+		{
+			p.SetState(317)
+			p.Match(GoParserASSIGN)
+		}
+
+	}
+	{
+		p.SetState(320)
+		p.Type_()
+	}
+
+	return localctx
+}
+
+// ITypeParametersContext is an interface to support dynamic dispatch.
+type ITypeParametersContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeParametersContext differentiates from other interfaces.
+	IsTypeParametersContext()
+}
+
+type TypeParametersContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeParametersContext() *TypeParametersContext {
+	var p = new(TypeParametersContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = GoParserRULE_typeParameters
+	return p
+}
+
+func (*TypeParametersContext) IsTypeParametersContext() {}
+
+func NewTypeParametersContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParametersContext {
+	var p = new(TypeParametersContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = GoParserRULE_typeParameters
+
+	return p
+}
+
+func (s *TypeParametersContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeParametersContext) L_BRACKET() antlr.TerminalNode {
+	return s.GetToken(GoParserL_BRACKET, 0)
+}
+
+func (s *TypeParametersContext) AllTypeParameterDecl() []ITypeParameterDeclContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypeParameterDeclContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypeParameterDeclContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypeParameterDeclContext); ok {
+			tst[i] = t.(ITypeParameterDeclContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TypeParametersContext) TypeParameterDecl(i int) ITypeParameterDeclContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeParameterDeclContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeParameterDeclContext)
+}
+
+func (s *TypeParametersContext) R_BRACKET() antlr.TerminalNode {
+	return s.GetToken(GoParserR_BRACKET, 0)
+}
+
+func (s *TypeParametersContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(GoParserCOMMA)
+}
+
+func (s *TypeParametersContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(GoParserCOMMA, i)
+}
+
+func (s *TypeParametersContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeParametersContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeParametersContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.EnterTypeParameters(s)
+	}
+}
+
+func (s *TypeParametersContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.ExitTypeParameters(s)
+	}
+}
+
+func (p *GoParser) TypeParameters() (localctx ITypeParametersContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeParametersContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, GoParserRULE_typeParameters)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(322)
+		p.Match(GoParserL_BRACKET)
+	}
+	{
+		p.SetState(323)
+		p.TypeParameterDecl()
+	}
+	p.SetState(328)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(324)
+				p.Match(GoParserCOMMA)
+			}
+			{
+				p.SetState(325)
+				p.TypeParameterDecl()
+			}
+
+		}
+		p.SetState(330)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext())
+	}
+	p.SetState(332)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == GoParserCOMMA {
+		{
+			p.SetState(331)
+			p.Match(GoParserCOMMA)
+		}
+
+	}
+	{
+		p.SetState(334)
+		p.Match(GoParserR_BRACKET)
+	}
+
+	return localctx
+}
+
+// ITypeParameterDeclContext is an interface to support dynamic dispatch.
+type ITypeParameterDeclContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeParameterDeclContext differentiates from other interfaces.
+	IsTypeParameterDeclContext()
+}
+
+type TypeParameterDeclContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeParameterDeclContext() *TypeParameterDeclContext {
+	var p = new(TypeParameterDeclContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = GoParserRULE_typeParameterDecl
+	return p
+}
+
+func (*TypeParameterDeclContext) IsTypeParameterDeclContext() {}
+
+func NewTypeParameterDeclContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeParameterDeclContext {
+	var p = new(TypeParameterDeclContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = GoParserRULE_typeParameterDecl
+
+	return p
+}
+
+func (s *TypeParameterDeclContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeParameterDeclContext) IdentifierList() IIdentifierListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IIdentifierListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IIdentifierListContext)
+}
+
+func (s *TypeParameterDeclContext) TypeElement() ITypeElementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeElementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeElementContext)
+}
+
+func (s *TypeParameterDeclContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeParameterDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeParameterDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.EnterTypeParameterDecl(s)
+	}
+}
+
+func (s *TypeParameterDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.ExitTypeParameterDecl(s)
+	}
+}
+
+func (p *GoParser) TypeParameterDecl() (localctx ITypeParameterDeclContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeParameterDeclContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, GoParserRULE_typeParameterDecl)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(336)
+		p.IdentifierList()
+	}
+	{
+		p.SetState(337)
+		p.TypeElement()
+	}
+
+	return localctx
+}
+
+// ITypeElementContext is an interface to support dynamic dispatch.
+type ITypeElementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeElementContext differentiates from other interfaces.
+	IsTypeElementContext()
+}
+
+type TypeElementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
 }
 
-func (s *TypeDeclContext) L_PAREN() antlr.TerminalNode {
-	return s.GetToken(GoParserL_PAREN, 0)
+func NewEmptyTypeElementContext() *TypeElementContext {
+	var p = new(TypeElementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = GoParserRULE_typeElement
+	return p
 }
 
-func (s *TypeDeclContext) R_PAREN() antlr.TerminalNode {
-	return s.GetToken(GoParserR_PAREN, 0)
+func (*TypeElementContext) IsTypeElementContext() {}
+
+func NewTypeElementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeElementContext {
+	var p = new(TypeElementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = GoParserRULE_typeElement
+
+	return p
 }
 
-func (s *TypeDeclContext) AllEos() []IEosContext {
+func (s *TypeElementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeElementContext) AllTypeTerm() []ITypeTermContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(IEosContext); ok {
+		if _, ok := ctx.(ITypeTermContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]IEosContext, len)
+	tst := make([]ITypeTermContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(IEosContext); ok {
-			tst[i] = t.(IEosContext)
+		if t, ok := ctx.(ITypeTermContext); ok {
+			tst[i] = t.(ITypeTermContext)
 			i++
 		}
 	}
@@ -2682,11 +3365,11 @@ func (s *TypeDeclContext) AllEos() []IEosContext {
 	return tst
 }
 
-func (s *TypeDeclContext) Eos(i int) IEosContext {
+func (s *TypeElementContext) TypeTerm(i int) ITypeTermContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(IEosContext); ok {
+		if _, ok := ctx.(ITypeTermContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -2699,36 +3382,43 @@ func (s *TypeDeclContext) Eos(i int) IEosContext {
 		return nil
 	}
 
-	return t.(IEosContext)
+	return t.(ITypeTermContext)
 }
 
-func (s *TypeDeclContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeElementContext) AllOR() []antlr.TerminalNode {
+	return s.GetTokens(GoParserOR)
+}
+
+func (s *TypeElementContext) OR(i int) antlr.TerminalNode {
+	return s.GetToken(GoParserOR, i)
+}
+
+func (s *TypeElementContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeDeclContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeElementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeDeclContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeElementContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(GoParserListener); ok {
-		listenerT.EnterTypeDecl(s)
+		listenerT.EnterTypeElement(s)
 	}
 }
 
-func (s *TypeDeclContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeElementContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(GoParserListener); ok {
-		listenerT.ExitTypeDecl(s)
+		listenerT.ExitTypeElement(s)
 	}
 }
 
-func (p *GoParser) TypeDecl() (localctx ITypeDeclContext) {
+func (p *GoParser) TypeElement() (localctx ITypeElementContext) {
 	this := p
 	_ = this
 
-	localctx = NewTypeDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, GoParserRULE_typeDecl)
-	var _la int
+	localctx = NewTypeElementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, GoParserRULE_typeElement)
 
 	defer func() {
 		p.ExitRule()
@@ -2746,99 +3436,76 @@ func (p *GoParser) TypeDecl() (localctx ITypeDeclContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(289)
-		p.Match(GoParserTYPE)
+		p.SetState(339)
+		p.TypeTerm()
 	}
-	p.SetState(301)
+	p.SetState(344)
 	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
 
-	switch p.GetTokenStream().LA(1) {
-	case GoParserIDENTIFIER:
-		{
-			p.SetState(290)
-			p.TypeSpec()
-		}
-
-	case GoParserL_PAREN:
-		{
-			p.SetState(291)
-			p.Match(GoParserL_PAREN)
-		}
-		p.SetState(297)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		for _la == GoParserIDENTIFIER {
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(292)
-				p.TypeSpec()
+				p.SetState(340)
+				p.Match(GoParserOR)
 			}
 			{
-				p.SetState(293)
-				p.Eos()
+				p.SetState(341)
+				p.TypeTerm()
 			}
 
-			p.SetState(299)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
-		}
-		{
-			p.SetState(300)
-			p.Match(GoParserR_PAREN)
 		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(346)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 19, p.GetParserRuleContext())
 	}
 
 	return localctx
 }
 
-// ITypeSpecContext is an interface to support dynamic dispatch.
-type ITypeSpecContext interface {
+// ITypeTermContext is an interface to support dynamic dispatch.
+type ITypeTermContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTypeSpecContext differentiates from other interfaces.
-	IsTypeSpecContext()
+	// IsTypeTermContext differentiates from other interfaces.
+	IsTypeTermContext()
 }
 
-type TypeSpecContext struct {
+type TypeTermContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTypeSpecContext() *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewEmptyTypeTermContext() *TypeTermContext {
+	var p = new(TypeTermContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = GoParserRULE_typeSpec
+	p.RuleIndex = GoParserRULE_typeTerm
 	return p
 }
 
-func (*TypeSpecContext) IsTypeSpecContext() {}
+func (*TypeTermContext) IsTypeTermContext() {}
 
-func NewTypeSpecContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeSpecContext {
-	var p = new(TypeSpecContext)
+func NewTypeTermContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeTermContext {
+	var p = new(TypeTermContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = GoParserRULE_typeSpec
+	p.RuleIndex = GoParserRULE_typeTerm
 
 	return p
 }
 
-func (s *TypeSpecContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TypeSpecContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(GoParserIDENTIFIER, 0)
-}
+func (s *TypeTermContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TypeSpecContext) Type_() IType_Context {
+func (s *TypeTermContext) Type_() IType_Context {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
 		if _, ok := ctx.(IType_Context); ok {
@@ -2854,36 +3521,36 @@ func (s *TypeSpecContext) Type_() IType_Context {
 	return t.(IType_Context)
 }
 
-func (s *TypeSpecContext) ASSIGN() antlr.TerminalNode {
-	return s.GetToken(GoParserASSIGN, 0)
+func (s *TypeTermContext) UNDERLYING() antlr.TerminalNode {
+	return s.GetToken(GoParserUNDERLYING, 0)
 }
 
-func (s *TypeSpecContext) GetRuleContext() antlr.RuleContext {
+func (s *TypeTermContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TypeSpecContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TypeTermContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TypeSpecContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TypeTermContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(GoParserListener); ok {
-		listenerT.EnterTypeSpec(s)
+		listenerT.EnterTypeTerm(s)
 	}
 }
 
-func (s *TypeSpecContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TypeTermContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(GoParserListener); ok {
-		listenerT.ExitTypeSpec(s)
+		listenerT.ExitTypeTerm(s)
 	}
 }
 
-func (p *GoParser) TypeSpec() (localctx ITypeSpecContext) {
+func (p *GoParser) TypeTerm() (localctx ITypeTermContext) {
 	this := p
 	_ = this
 
-	localctx = NewTypeSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, GoParserRULE_typeSpec)
+	localctx = NewTypeTermContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, GoParserRULE_typeTerm)
 	var _la int
 
 	defer func() {
@@ -2903,23 +3570,19 @@ func (p *GoParser) TypeSpec() (localctx ITypeSpecContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(303)
-		p.Match(GoParserIDENTIFIER)
-	}
-	p.SetState(305)
+	p.SetState(348)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == GoParserASSIGN {
+	if _la == GoParserUNDERLYING {
 		{
-			p.SetState(304)
-			p.Match(GoParserASSIGN)
+			p.SetState(347)
+			p.Match(GoParserUNDERLYING)
 		}
 
 	}
 	{
-		p.SetState(307)
+		p.SetState(350)
 		p.Type_()
 	}
 
@@ -2988,6 +3651,22 @@ func (s *FunctionDeclContext) Signature() ISignatureContext {
 	return t.(ISignatureContext)
 }
 
+func (s *FunctionDeclContext) TypeParameters() ITypeParametersContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeParametersContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeParametersContext)
+}
+
 func (s *FunctionDeclContext) Block() IBlockContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -3029,7 +3708,8 @@ func (p *GoParser) FunctionDecl() (localctx IFunctionDeclContext) {
 	_ = this
 
 	localctx = NewFunctionDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, GoParserRULE_functionDecl)
+	p.EnterRule(localctx, 32, GoParserRULE_functionDecl)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3049,24 +3729,35 @@ func (p *GoParser) FunctionDecl() (localctx IFunctionDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(309)
+		p.SetState(352)
 		p.Match(GoParserFUNC)
 	}
 	{
-		p.SetState(310)
+		p.SetState(353)
 		p.Match(GoParserIDENTIFIER)
 	}
+	p.SetState(355)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == GoParserL_BRACKET {
+		{
+			p.SetState(354)
+			p.TypeParameters()
+		}
+
+	}
 
 	{
-		p.SetState(311)
+		p.SetState(357)
 		p.Signature()
 	}
-	p.SetState(313)
+	p.SetState(359)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 16, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(312)
+			p.SetState(358)
 			p.Block()
 		}
 
@@ -3194,7 +3885,7 @@ func (p *GoParser) MethodDecl() (localctx IMethodDeclContext) {
 	_ = this
 
 	localctx = NewMethodDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, GoParserRULE_methodDecl)
+	p.EnterRule(localctx, 34, GoParserRULE_methodDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -3214,28 +3905,28 @@ func (p *GoParser) MethodDecl() (localctx IMethodDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(315)
+		p.SetState(361)
 		p.Match(GoParserFUNC)
 	}
 	{
-		p.SetState(316)
+		p.SetState(362)
 		p.Receiver()
 	}
 	{
-		p.SetState(317)
+		p.SetState(363)
 		p.Match(GoParserIDENTIFIER)
 	}
 
 	{
-		p.SetState(318)
+		p.SetState(364)
 		p.Signature()
 	}
-	p.SetState(320)
+	p.SetState(366)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 23, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(319)
+			p.SetState(365)
 			p.Block()
 		}
 
@@ -3323,7 +4014,7 @@ func (p *GoParser) Receiver() (localctx IReceiverContext) {
 	_ = this
 
 	localctx = NewReceiverContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, GoParserRULE_receiver)
+	p.EnterRule(localctx, 36, GoParserRULE_receiver)
 
 	defer func() {
 		p.ExitRule()
@@ -3343,7 +4034,7 @@ func (p *GoParser) Receiver() (localctx IReceiverContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(322)
+		p.SetState(368)
 		p.Parameters()
 	}
 
@@ -3507,7 +4198,7 @@ func (p *GoParser) VarDecl() (localctx IVarDeclContext) {
 	_ = this
 
 	localctx = NewVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, GoParserRULE_varDecl)
+	p.EnterRule(localctx, 38, GoParserRULE_varDecl)
 	var _la int
 
 	defer func() {
@@ -3528,44 +4219,44 @@ func (p *GoParser) VarDecl() (localctx IVarDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(324)
+		p.SetState(370)
 		p.Match(GoParserVAR)
 	}
-	p.SetState(336)
+	p.SetState(382)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserIDENTIFIER:
 		{
-			p.SetState(325)
+			p.SetState(371)
 			p.VarSpec()
 		}
 
 	case GoParserL_PAREN:
 		{
-			p.SetState(326)
+			p.SetState(372)
 			p.Match(GoParserL_PAREN)
 		}
-		p.SetState(332)
+		p.SetState(378)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == GoParserIDENTIFIER {
 			{
-				p.SetState(327)
+				p.SetState(373)
 				p.VarSpec()
 			}
 			{
-				p.SetState(328)
+				p.SetState(374)
 				p.Eos()
 			}
 
-			p.SetState(334)
+			p.SetState(380)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(335)
+			p.SetState(381)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -3691,7 +4382,7 @@ func (p *GoParser) VarSpec() (localctx IVarSpecContext) {
 	_ = this
 
 	localctx = NewVarSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, GoParserRULE_varSpec)
+	p.EnterRule(localctx, 40, GoParserRULE_varSpec)
 
 	defer func() {
 		p.ExitRule()
@@ -3711,28 +4402,28 @@ func (p *GoParser) VarSpec() (localctx IVarSpecContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(338)
+		p.SetState(384)
 		p.IdentifierList()
 	}
-	p.SetState(346)
+	p.SetState(392)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
 		{
-			p.SetState(339)
+			p.SetState(385)
 			p.Type_()
 		}
-		p.SetState(342)
+		p.SetState(388)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 20, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(340)
+				p.SetState(386)
 				p.Match(GoParserASSIGN)
 			}
 			{
-				p.SetState(341)
+				p.SetState(387)
 				p.ExpressionList()
 			}
 
@@ -3740,11 +4431,11 @@ func (p *GoParser) VarSpec() (localctx IVarSpecContext) {
 
 	case GoParserASSIGN:
 		{
-			p.SetState(344)
+			p.SetState(390)
 			p.Match(GoParserASSIGN)
 		}
 		{
-			p.SetState(345)
+			p.SetState(391)
 			p.ExpressionList()
 		}
 
@@ -3842,7 +4533,7 @@ func (p *GoParser) Block() (localctx IBlockContext) {
 	_ = this
 
 	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, GoParserRULE_block)
+	p.EnterRule(localctx, 42, GoParserRULE_block)
 
 	defer func() {
 		p.ExitRule()
@@ -3862,21 +4553,21 @@ func (p *GoParser) Block() (localctx IBlockContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(348)
+		p.SetState(394)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(350)
+	p.SetState(396)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 22, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(349)
+			p.SetState(395)
 			p.StatementList()
 		}
 
 	}
 	{
-		p.SetState(352)
+		p.SetState(398)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -4044,7 +4735,7 @@ func (p *GoParser) StatementList() (localctx IStatementListContext) {
 	_ = this
 
 	localctx = NewStatementListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, GoParserRULE_statementList)
+	p.EnterRule(localctx, 44, GoParserRULE_statementList)
 	var _la int
 
 	defer func() {
@@ -4066,43 +4757,43 @@ func (p *GoParser) StatementList() (localctx IStatementListContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(366)
+	p.SetState(412)
 	p.GetErrorHandler().Sync(p)
 	_alt = 1
 	for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		switch _alt {
 		case 1:
-			p.SetState(361)
+			p.SetState(407)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) {
 			case 1:
-				p.SetState(355)
+				p.SetState(401)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == GoParserSEMI {
 					{
-						p.SetState(354)
+						p.SetState(400)
 						p.Match(GoParserSEMI)
 					}
 
 				}
 
 			case 2:
-				p.SetState(358)
+				p.SetState(404)
 				p.GetErrorHandler().Sync(p)
 				_la = p.GetTokenStream().LA(1)
 
 				if _la == GoParserEOS {
 					{
-						p.SetState(357)
+						p.SetState(403)
 						p.Match(GoParserEOS)
 					}
 
 				}
 
 			case 3:
-				p.SetState(360)
+				p.SetState(406)
 
 				if !(p.closingBracket()) {
 					panic(antlr.NewFailedPredicateException(p, "closingBracket()", ""))
@@ -4110,11 +4801,11 @@ func (p *GoParser) StatementList() (localctx IStatementListContext) {
 
 			}
 			{
-				p.SetState(363)
+				p.SetState(409)
 				p.Statement()
 			}
 			{
-				p.SetState(364)
+				p.SetState(410)
 				p.Eos()
 			}
 
@@ -4122,9 +4813,9 @@ func (p *GoParser) StatementList() (localctx IStatementListContext) {
 			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
 
-		p.SetState(368)
+		p.SetState(414)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 26, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -4433,7 +5124,7 @@ func (p *GoParser) Statement() (localctx IStatementContext) {
 	_ = this
 
 	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, GoParserRULE_statement)
+	p.EnterRule(localctx, 46, GoParserRULE_statement)
 
 	defer func() {
 		p.ExitRule()
@@ -4451,111 +5142,111 @@ func (p *GoParser) Statement() (localctx IStatementContext) {
 		}
 	}()
 
-	p.SetState(385)
+	p.SetState(431)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 27, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(370)
+			p.SetState(416)
 			p.Declaration()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(371)
+			p.SetState(417)
 			p.LabeledStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(372)
+			p.SetState(418)
 			p.SimpleStmt()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(373)
+			p.SetState(419)
 			p.GoStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(374)
+			p.SetState(420)
 			p.ReturnStmt()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(375)
+			p.SetState(421)
 			p.BreakStmt()
 		}
 
 	case 7:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(376)
+			p.SetState(422)
 			p.ContinueStmt()
 		}
 
 	case 8:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(377)
+			p.SetState(423)
 			p.GotoStmt()
 		}
 
 	case 9:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(378)
+			p.SetState(424)
 			p.FallthroughStmt()
 		}
 
 	case 10:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(379)
+			p.SetState(425)
 			p.Block()
 		}
 
 	case 11:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(380)
+			p.SetState(426)
 			p.IfStmt()
 		}
 
 	case 12:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(381)
+			p.SetState(427)
 			p.SwitchStmt()
 		}
 
 	case 13:
 		p.EnterOuterAlt(localctx, 13)
 		{
-			p.SetState(382)
+			p.SetState(428)
 			p.SelectStmt()
 		}
 
 	case 14:
 		p.EnterOuterAlt(localctx, 14)
 		{
-			p.SetState(383)
+			p.SetState(429)
 			p.ForStmt()
 		}
 
 	case 15:
 		p.EnterOuterAlt(localctx, 15)
 		{
-			p.SetState(384)
+			p.SetState(430)
 			p.DeferStmt()
 		}
 
@@ -4707,7 +5398,7 @@ func (p *GoParser) SimpleStmt() (localctx ISimpleStmtContext) {
 	_ = this
 
 	localctx = NewSimpleStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, GoParserRULE_simpleStmt)
+	p.EnterRule(localctx, 48, GoParserRULE_simpleStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4725,41 +5416,41 @@ func (p *GoParser) SimpleStmt() (localctx ISimpleStmtContext) {
 		}
 	}()
 
-	p.SetState(392)
+	p.SetState(438)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 28, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(387)
+			p.SetState(433)
 			p.SendStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(388)
+			p.SetState(434)
 			p.IncDecStmt()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(389)
+			p.SetState(435)
 			p.Assignment()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(390)
+			p.SetState(436)
 			p.ExpressionStmt()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(391)
+			p.SetState(437)
 			p.ShortVarDecl()
 		}
 
@@ -4847,7 +5538,7 @@ func (p *GoParser) ExpressionStmt() (localctx IExpressionStmtContext) {
 	_ = this
 
 	localctx = NewExpressionStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, GoParserRULE_expressionStmt)
+	p.EnterRule(localctx, 50, GoParserRULE_expressionStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -4867,7 +5558,7 @@ func (p *GoParser) ExpressionStmt() (localctx IExpressionStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(394)
+		p.SetState(440)
 		p.expression(0)
 	}
 
@@ -4993,7 +5684,7 @@ func (p *GoParser) SendStmt() (localctx ISendStmtContext) {
 	_ = this
 
 	localctx = NewSendStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, GoParserRULE_sendStmt)
+	p.EnterRule(localctx, 52, GoParserRULE_sendStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5013,18 +5704,18 @@ func (p *GoParser) SendStmt() (localctx ISendStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(396)
+		p.SetState(442)
 
 		var _x = p.expression(0)
 
 		localctx.(*SendStmtContext).channel = _x
 	}
 	{
-		p.SetState(397)
+		p.SetState(443)
 		p.Match(GoParserRECEIVE)
 	}
 	{
-		p.SetState(398)
+		p.SetState(444)
 		p.expression(0)
 	}
 
@@ -5118,7 +5809,7 @@ func (p *GoParser) IncDecStmt() (localctx IIncDecStmtContext) {
 	_ = this
 
 	localctx = NewIncDecStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, GoParserRULE_incDecStmt)
+	p.EnterRule(localctx, 54, GoParserRULE_incDecStmt)
 	var _la int
 
 	defer func() {
@@ -5139,11 +5830,11 @@ func (p *GoParser) IncDecStmt() (localctx IIncDecStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(400)
+		p.SetState(446)
 		p.expression(0)
 	}
 	{
-		p.SetState(401)
+		p.SetState(447)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == GoParserPLUS_PLUS || _la == GoParserMINUS_MINUS) {
@@ -5277,7 +5968,7 @@ func (p *GoParser) Assignment() (localctx IAssignmentContext) {
 	_ = this
 
 	localctx = NewAssignmentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, GoParserRULE_assignment)
+	p.EnterRule(localctx, 56, GoParserRULE_assignment)
 
 	defer func() {
 		p.ExitRule()
@@ -5297,15 +5988,15 @@ func (p *GoParser) Assignment() (localctx IAssignmentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(403)
+		p.SetState(449)
 		p.ExpressionList()
 	}
 	{
-		p.SetState(404)
+		p.SetState(450)
 		p.Assign_op()
 	}
 	{
-		p.SetState(405)
+		p.SetState(451)
 		p.ExpressionList()
 	}
 
@@ -5423,7 +6114,7 @@ func (p *GoParser) Assign_op() (localctx IAssign_opContext) {
 	_ = this
 
 	localctx = NewAssign_opContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, GoParserRULE_assign_op)
+	p.EnterRule(localctx, 58, GoParserRULE_assign_op)
 	var _la int
 
 	defer func() {
@@ -5443,13 +6134,13 @@ func (p *GoParser) Assign_op() (localctx IAssign_opContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(408)
+	p.SetState(454)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if ((_la-51)&-(0x1f+1)) == 0 && ((1<<uint((_la-51)))&((1<<(GoParserOR-51))|(1<<(GoParserDIV-51))|(1<<(GoParserMOD-51))|(1<<(GoParserLSHIFT-51))|(1<<(GoParserRSHIFT-51))|(1<<(GoParserBIT_CLEAR-51))|(1<<(GoParserPLUS-51))|(1<<(GoParserMINUS-51))|(1<<(GoParserCARET-51))|(1<<(GoParserSTAR-51))|(1<<(GoParserAMPERSAND-51)))) != 0 {
 		{
-			p.SetState(407)
+			p.SetState(453)
 			_la = p.GetTokenStream().LA(1)
 
 			if !(((_la-51)&-(0x1f+1)) == 0 && ((1<<uint((_la-51)))&((1<<(GoParserOR-51))|(1<<(GoParserDIV-51))|(1<<(GoParserMOD-51))|(1<<(GoParserLSHIFT-51))|(1<<(GoParserRSHIFT-51))|(1<<(GoParserBIT_CLEAR-51))|(1<<(GoParserPLUS-51))|(1<<(GoParserMINUS-51))|(1<<(GoParserCARET-51))|(1<<(GoParserSTAR-51))|(1<<(GoParserAMPERSAND-51)))) != 0) {
@@ -5462,7 +6153,7 @@ func (p *GoParser) Assign_op() (localctx IAssign_opContext) {
 
 	}
 	{
-		p.SetState(410)
+		p.SetState(456)
 		p.Match(GoParserASSIGN)
 	}
 
@@ -5568,7 +6259,7 @@ func (p *GoParser) ShortVarDecl() (localctx IShortVarDeclContext) {
 	_ = this
 
 	localctx = NewShortVarDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, GoParserRULE_shortVarDecl)
+	p.EnterRule(localctx, 60, GoParserRULE_shortVarDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -5588,15 +6279,15 @@ func (p *GoParser) ShortVarDecl() (localctx IShortVarDeclContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(412)
+		p.SetState(458)
 		p.IdentifierList()
 	}
 	{
-		p.SetState(413)
+		p.SetState(459)
 		p.Match(GoParserDECLARE_ASSIGN)
 	}
 	{
-		p.SetState(414)
+		p.SetState(460)
 		p.ExpressionList()
 	}
 
@@ -5674,7 +6365,7 @@ func (p *GoParser) EmptyStmt() (localctx IEmptyStmtContext) {
 	_ = this
 
 	localctx = NewEmptyStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, GoParserRULE_emptyStmt)
+	p.EnterRule(localctx, 62, GoParserRULE_emptyStmt)
 	var _la int
 
 	defer func() {
@@ -5695,7 +6386,7 @@ func (p *GoParser) EmptyStmt() (localctx IEmptyStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(416)
+		p.SetState(462)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == GoParserSEMI || _la == GoParserEOS) {
@@ -5796,7 +6487,7 @@ func (p *GoParser) LabeledStmt() (localctx ILabeledStmtContext) {
 	_ = this
 
 	localctx = NewLabeledStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, GoParserRULE_labeledStmt)
+	p.EnterRule(localctx, 64, GoParserRULE_labeledStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5816,19 +6507,19 @@ func (p *GoParser) LabeledStmt() (localctx ILabeledStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(418)
+		p.SetState(464)
 		p.Match(GoParserIDENTIFIER)
 	}
 	{
-		p.SetState(419)
+		p.SetState(465)
 		p.Match(GoParserCOLON)
 	}
-	p.SetState(421)
+	p.SetState(467)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 30, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(420)
+			p.SetState(466)
 			p.Statement()
 		}
 
@@ -5920,7 +6611,7 @@ func (p *GoParser) ReturnStmt() (localctx IReturnStmtContext) {
 	_ = this
 
 	localctx = NewReturnStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, GoParserRULE_returnStmt)
+	p.EnterRule(localctx, 66, GoParserRULE_returnStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -5940,15 +6631,15 @@ func (p *GoParser) ReturnStmt() (localctx IReturnStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(423)
+		p.SetState(469)
 		p.Match(GoParserRETURN)
 	}
-	p.SetState(425)
+	p.SetState(471)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 31, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(424)
+			p.SetState(470)
 			p.ExpressionList()
 		}
 
@@ -6028,7 +6719,7 @@ func (p *GoParser) BreakStmt() (localctx IBreakStmtContext) {
 	_ = this
 
 	localctx = NewBreakStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, GoParserRULE_breakStmt)
+	p.EnterRule(localctx, 68, GoParserRULE_breakStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6048,15 +6739,15 @@ func (p *GoParser) BreakStmt() (localctx IBreakStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(427)
+		p.SetState(473)
 		p.Match(GoParserBREAK)
 	}
-	p.SetState(429)
+	p.SetState(475)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 32, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 38, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(428)
+			p.SetState(474)
 			p.Match(GoParserIDENTIFIER)
 		}
 
@@ -6136,7 +6827,7 @@ func (p *GoParser) ContinueStmt() (localctx IContinueStmtContext) {
 	_ = this
 
 	localctx = NewContinueStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, GoParserRULE_continueStmt)
+	p.EnterRule(localctx, 70, GoParserRULE_continueStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6156,15 +6847,15 @@ func (p *GoParser) ContinueStmt() (localctx IContinueStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(431)
+		p.SetState(477)
 		p.Match(GoParserCONTINUE)
 	}
-	p.SetState(433)
+	p.SetState(479)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 33, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(432)
+			p.SetState(478)
 			p.Match(GoParserIDENTIFIER)
 		}
 
@@ -6244,7 +6935,7 @@ func (p *GoParser) GotoStmt() (localctx IGotoStmtContext) {
 	_ = this
 
 	localctx = NewGotoStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, GoParserRULE_gotoStmt)
+	p.EnterRule(localctx, 72, GoParserRULE_gotoStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6264,11 +6955,11 @@ func (p *GoParser) GotoStmt() (localctx IGotoStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(435)
+		p.SetState(481)
 		p.Match(GoParserGOTO)
 	}
 	{
-		p.SetState(436)
+		p.SetState(482)
 		p.Match(GoParserIDENTIFIER)
 	}
 
@@ -6342,7 +7033,7 @@ func (p *GoParser) FallthroughStmt() (localctx IFallthroughStmtContext) {
 	_ = this
 
 	localctx = NewFallthroughStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 66, GoParserRULE_fallthroughStmt)
+	p.EnterRule(localctx, 74, GoParserRULE_fallthroughStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6362,7 +7053,7 @@ func (p *GoParser) FallthroughStmt() (localctx IFallthroughStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(438)
+		p.SetState(484)
 		p.Match(GoParserFALLTHROUGH)
 	}
 
@@ -6452,7 +7143,7 @@ func (p *GoParser) DeferStmt() (localctx IDeferStmtContext) {
 	_ = this
 
 	localctx = NewDeferStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 68, GoParserRULE_deferStmt)
+	p.EnterRule(localctx, 76, GoParserRULE_deferStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6472,11 +7163,11 @@ func (p *GoParser) DeferStmt() (localctx IDeferStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(440)
+		p.SetState(486)
 		p.Match(GoParserDEFER)
 	}
 	{
-		p.SetState(441)
+		p.SetState(487)
 		p.expression(0)
 	}
 
@@ -6659,7 +7350,7 @@ func (p *GoParser) IfStmt() (localctx IIfStmtContext) {
 	_ = this
 
 	localctx = NewIfStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 70, GoParserRULE_ifStmt)
+	p.EnterRule(localctx, 78, GoParserRULE_ifStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6679,68 +7370,68 @@ func (p *GoParser) IfStmt() (localctx IIfStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(443)
+		p.SetState(489)
 		p.Match(GoParserIF)
 	}
-	p.SetState(452)
+	p.SetState(498)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 34, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 40, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(444)
+			p.SetState(490)
 			p.expression(0)
 		}
 
 	case 2:
 		{
-			p.SetState(445)
+			p.SetState(491)
 			p.Eos()
 		}
 		{
-			p.SetState(446)
+			p.SetState(492)
 			p.expression(0)
 		}
 
 	case 3:
 		{
-			p.SetState(448)
+			p.SetState(494)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(449)
+			p.SetState(495)
 			p.Eos()
 		}
 		{
-			p.SetState(450)
+			p.SetState(496)
 			p.expression(0)
 		}
 
 	}
 	{
-		p.SetState(454)
+		p.SetState(500)
 		p.Block()
 	}
-	p.SetState(460)
+	p.SetState(506)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 36, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 42, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(455)
+			p.SetState(501)
 			p.Match(GoParserELSE)
 		}
-		p.SetState(458)
+		p.SetState(504)
 		p.GetErrorHandler().Sync(p)
 
 		switch p.GetTokenStream().LA(1) {
 		case GoParserIF:
 			{
-				p.SetState(456)
+				p.SetState(502)
 				p.IfStmt()
 			}
 
 		case GoParserL_CURLY:
 			{
-				p.SetState(457)
+				p.SetState(503)
 				p.Block()
 			}
 
@@ -6848,7 +7539,7 @@ func (p *GoParser) SwitchStmt() (localctx ISwitchStmtContext) {
 	_ = this
 
 	localctx = NewSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 72, GoParserRULE_switchStmt)
+	p.EnterRule(localctx, 80, GoParserRULE_switchStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -6866,20 +7557,20 @@ func (p *GoParser) SwitchStmt() (localctx ISwitchStmtContext) {
 		}
 	}()
 
-	p.SetState(464)
+	p.SetState(510)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 37, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(462)
+			p.SetState(508)
 			p.ExprSwitchStmt()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(463)
+			p.SetState(509)
 			p.TypeSwitchStmt()
 		}
 
@@ -7052,7 +7743,7 @@ func (p *GoParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
 	_ = this
 
 	localctx = NewExprSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 74, GoParserRULE_exprSwitchStmt)
+	p.EnterRule(localctx, 82, GoParserRULE_exprSwitchStmt)
 	var _la int
 
 	defer func() {
@@ -7073,47 +7764,47 @@ func (p *GoParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(466)
+		p.SetState(512)
 		p.Match(GoParserSWITCH)
 	}
-	p.SetState(477)
+	p.SetState(523)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 41, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(468)
+		p.SetState(514)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(467)
+				p.SetState(513)
 				p.expression(0)
 			}
 
 		}
 
 	case 2:
-		p.SetState(471)
+		p.SetState(517)
 		p.GetErrorHandler().Sync(p)
 
-		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 39, p.GetParserRuleContext()) == 1 {
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) == 1 {
 			{
-				p.SetState(470)
+				p.SetState(516)
 				p.SimpleStmt()
 			}
 
 		}
 		{
-			p.SetState(473)
+			p.SetState(519)
 			p.Eos()
 		}
-		p.SetState(475)
+		p.SetState(521)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(474)
+				p.SetState(520)
 				p.expression(0)
 			}
 
@@ -7121,25 +7812,25 @@ func (p *GoParser) ExprSwitchStmt() (localctx IExprSwitchStmtContext) {
 
 	}
 	{
-		p.SetState(479)
+		p.SetState(525)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(483)
+	p.SetState(529)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == GoParserDEFAULT || _la == GoParserCASE {
 		{
-			p.SetState(480)
+			p.SetState(526)
 			p.ExprCaseClause()
 		}
 
-		p.SetState(485)
+		p.SetState(531)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(486)
+		p.SetState(532)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -7245,7 +7936,7 @@ func (p *GoParser) ExprCaseClause() (localctx IExprCaseClauseContext) {
 	_ = this
 
 	localctx = NewExprCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 76, GoParserRULE_exprCaseClause)
+	p.EnterRule(localctx, 84, GoParserRULE_exprCaseClause)
 
 	defer func() {
 		p.ExitRule()
@@ -7265,19 +7956,19 @@ func (p *GoParser) ExprCaseClause() (localctx IExprCaseClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(488)
+		p.SetState(534)
 		p.ExprSwitchCase()
 	}
 	{
-		p.SetState(489)
+		p.SetState(535)
 		p.Match(GoParserCOLON)
 	}
-	p.SetState(491)
+	p.SetState(537)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(490)
+			p.SetState(536)
 			p.StatementList()
 		}
 
@@ -7373,7 +8064,7 @@ func (p *GoParser) ExprSwitchCase() (localctx IExprSwitchCaseContext) {
 	_ = this
 
 	localctx = NewExprSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 78, GoParserRULE_exprSwitchCase)
+	p.EnterRule(localctx, 86, GoParserRULE_exprSwitchCase)
 
 	defer func() {
 		p.ExitRule()
@@ -7391,25 +8082,25 @@ func (p *GoParser) ExprSwitchCase() (localctx IExprSwitchCaseContext) {
 		}
 	}()
 
-	p.SetState(496)
+	p.SetState(542)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserCASE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(493)
+			p.SetState(539)
 			p.Match(GoParserCASE)
 		}
 		{
-			p.SetState(494)
+			p.SetState(540)
 			p.ExpressionList()
 		}
 
 	case GoParserDEFAULT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(495)
+			p.SetState(541)
 			p.Match(GoParserDEFAULT)
 		}
 
@@ -7584,7 +8275,7 @@ func (p *GoParser) TypeSwitchStmt() (localctx ITypeSwitchStmtContext) {
 	_ = this
 
 	localctx = NewTypeSwitchStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 80, GoParserRULE_typeSwitchStmt)
+	p.EnterRule(localctx, 88, GoParserRULE_typeSwitchStmt)
 	var _la int
 
 	defer func() {
@@ -7605,63 +8296,63 @@ func (p *GoParser) TypeSwitchStmt() (localctx ITypeSwitchStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(498)
+		p.SetState(544)
 		p.Match(GoParserSWITCH)
 	}
-	p.SetState(507)
+	p.SetState(553)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 45, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 51, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(499)
+			p.SetState(545)
 			p.TypeSwitchGuard()
 		}
 
 	case 2:
 		{
-			p.SetState(500)
+			p.SetState(546)
 			p.Eos()
 		}
 		{
-			p.SetState(501)
+			p.SetState(547)
 			p.TypeSwitchGuard()
 		}
 
 	case 3:
 		{
-			p.SetState(503)
+			p.SetState(549)
 			p.SimpleStmt()
 		}
 		{
-			p.SetState(504)
+			p.SetState(550)
 			p.Eos()
 		}
 		{
-			p.SetState(505)
+			p.SetState(551)
 			p.TypeSwitchGuard()
 		}
 
 	}
 	{
-		p.SetState(509)
+		p.SetState(555)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(513)
+	p.SetState(559)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == GoParserDEFAULT || _la == GoParserCASE {
 		{
-			p.SetState(510)
+			p.SetState(556)
 			p.TypeCaseClause()
 		}
 
-		p.SetState(515)
+		p.SetState(561)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(516)
+		p.SetState(562)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -7771,7 +8462,7 @@ func (p *GoParser) TypeSwitchGuard() (localctx ITypeSwitchGuardContext) {
 	_ = this
 
 	localctx = NewTypeSwitchGuardContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 82, GoParserRULE_typeSwitchGuard)
+	p.EnterRule(localctx, 90, GoParserRULE_typeSwitchGuard)
 
 	defer func() {
 		p.ExitRule()
@@ -7790,38 +8481,38 @@ func (p *GoParser) TypeSwitchGuard() (localctx ITypeSwitchGuardContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(520)
+	p.SetState(566)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 47, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 53, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(518)
+			p.SetState(564)
 			p.Match(GoParserIDENTIFIER)
 		}
 		{
-			p.SetState(519)
+			p.SetState(565)
 			p.Match(GoParserDECLARE_ASSIGN)
 		}
 
 	}
 	{
-		p.SetState(522)
+		p.SetState(568)
 		p.primaryExpr(0)
 	}
 	{
-		p.SetState(523)
+		p.SetState(569)
 		p.Match(GoParserDOT)
 	}
 	{
-		p.SetState(524)
+		p.SetState(570)
 		p.Match(GoParserL_PAREN)
 	}
 	{
-		p.SetState(525)
+		p.SetState(571)
 		p.Match(GoParserTYPE)
 	}
 	{
-		p.SetState(526)
+		p.SetState(572)
 		p.Match(GoParserR_PAREN)
 	}
 
@@ -7927,7 +8618,7 @@ func (p *GoParser) TypeCaseClause() (localctx ITypeCaseClauseContext) {
 	_ = this
 
 	localctx = NewTypeCaseClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 84, GoParserRULE_typeCaseClause)
+	p.EnterRule(localctx, 92, GoParserRULE_typeCaseClause)
 
 	defer func() {
 		p.ExitRule()
@@ -7947,19 +8638,19 @@ func (p *GoParser) TypeCaseClause() (localctx ITypeCaseClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(528)
+		p.SetState(574)
 		p.TypeSwitchCase()
 	}
 	{
-		p.SetState(529)
+		p.SetState(575)
 		p.Match(GoParserCOLON)
 	}
-	p.SetState(531)
+	p.SetState(577)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 48, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(530)
+			p.SetState(576)
 			p.StatementList()
 		}
 
@@ -8055,7 +8746,7 @@ func (p *GoParser) TypeSwitchCase() (localctx ITypeSwitchCaseContext) {
 	_ = this
 
 	localctx = NewTypeSwitchCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 86, GoParserRULE_typeSwitchCase)
+	p.EnterRule(localctx, 94, GoParserRULE_typeSwitchCase)
 
 	defer func() {
 		p.ExitRule()
@@ -8073,25 +8764,25 @@ func (p *GoParser) TypeSwitchCase() (localctx ITypeSwitchCaseContext) {
 		}
 	}()
 
-	p.SetState(536)
+	p.SetState(582)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserCASE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(533)
+			p.SetState(579)
 			p.Match(GoParserCASE)
 		}
 		{
-			p.SetState(534)
+			p.SetState(580)
 			p.TypeList()
 		}
 
 	case GoParserDEFAULT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(535)
+			p.SetState(581)
 			p.Match(GoParserDEFAULT)
 		}
 
@@ -8222,8 +8913,7 @@ func (p *GoParser) TypeList() (localctx ITypeListContext) {
 	_ = this
 
 	localctx = NewTypeListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 88, GoParserRULE_typeList)
-	var _la int
+	p.EnterRule(localctx, 96, GoParserRULE_typeList)
 
 	defer func() {
 		p.ExitRule()
@@ -8241,58 +8931,62 @@ func (p *GoParser) TypeList() (localctx ITypeListContext) {
 		}
 	}()
 
+	var _alt int
+
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(540)
+	p.SetState(586)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
 		{
-			p.SetState(538)
+			p.SetState(584)
 			p.Type_()
 		}
 
 	case GoParserNIL_LIT:
 		{
-			p.SetState(539)
+			p.SetState(585)
 			p.Match(GoParserNIL_LIT)
 		}
 
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(549)
+	p.SetState(595)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for _la == GoParserCOMMA {
-		{
-			p.SetState(542)
-			p.Match(GoParserCOMMA)
-		}
-		p.SetState(545)
-		p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext())
 
-		switch p.GetTokenStream().LA(1) {
-		case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
 			{
-				p.SetState(543)
-				p.Type_()
+				p.SetState(588)
+				p.Match(GoParserCOMMA)
 			}
+			p.SetState(591)
+			p.GetErrorHandler().Sync(p)
 
-		case GoParserNIL_LIT:
-			{
-				p.SetState(544)
-				p.Match(GoParserNIL_LIT)
+			switch p.GetTokenStream().LA(1) {
+			case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
+				{
+					p.SetState(589)
+					p.Type_()
+				}
+
+			case GoParserNIL_LIT:
+				{
+					p.SetState(590)
+					p.Match(GoParserNIL_LIT)
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 			}
 
-		default:
-			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 		}
-
-		p.SetState(551)
+		p.SetState(597)
 		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 58, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -8414,7 +9108,7 @@ func (p *GoParser) SelectStmt() (localctx ISelectStmtContext) {
 	_ = this
 
 	localctx = NewSelectStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 90, GoParserRULE_selectStmt)
+	p.EnterRule(localctx, 98, GoParserRULE_selectStmt)
 	var _la int
 
 	defer func() {
@@ -8435,29 +9129,29 @@ func (p *GoParser) SelectStmt() (localctx ISelectStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(552)
+		p.SetState(598)
 		p.Match(GoParserSELECT)
 	}
 	{
-		p.SetState(553)
+		p.SetState(599)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(557)
+	p.SetState(603)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == GoParserDEFAULT || _la == GoParserCASE {
 		{
-			p.SetState(554)
+			p.SetState(600)
 			p.CommClause()
 		}
 
-		p.SetState(559)
+		p.SetState(605)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(560)
+		p.SetState(606)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -8563,7 +9257,7 @@ func (p *GoParser) CommClause() (localctx ICommClauseContext) {
 	_ = this
 
 	localctx = NewCommClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 92, GoParserRULE_commClause)
+	p.EnterRule(localctx, 100, GoParserRULE_commClause)
 
 	defer func() {
 		p.ExitRule()
@@ -8583,19 +9277,19 @@ func (p *GoParser) CommClause() (localctx ICommClauseContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(562)
+		p.SetState(608)
 		p.CommCase()
 	}
 	{
-		p.SetState(563)
+		p.SetState(609)
 		p.Match(GoParserCOLON)
 	}
-	p.SetState(565)
+	p.SetState(611)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 54, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(564)
+			p.SetState(610)
 			p.StatementList()
 		}
 
@@ -8707,7 +9401,7 @@ func (p *GoParser) CommCase() (localctx ICommCaseContext) {
 	_ = this
 
 	localctx = NewCommCaseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 94, GoParserRULE_commCase)
+	p.EnterRule(localctx, 102, GoParserRULE_commCase)
 
 	defer func() {
 		p.ExitRule()
@@ -8725,28 +9419,28 @@ func (p *GoParser) CommCase() (localctx ICommCaseContext) {
 		}
 	}()
 
-	p.SetState(573)
+	p.SetState(619)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserCASE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(567)
+			p.SetState(613)
 			p.Match(GoParserCASE)
 		}
-		p.SetState(570)
+		p.SetState(616)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 55, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(568)
+				p.SetState(614)
 				p.SendStmt()
 			}
 
 		case 2:
 			{
-				p.SetState(569)
+				p.SetState(615)
 				p.RecvStmt()
 			}
 
@@ -8755,7 +9449,7 @@ func (p *GoParser) CommCase() (localctx ICommCaseContext) {
 	case GoParserDEFAULT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(572)
+			p.SetState(618)
 			p.Match(GoParserDEFAULT)
 		}
 
@@ -8896,7 +9590,7 @@ func (p *GoParser) RecvStmt() (localctx IRecvStmtContext) {
 	_ = this
 
 	localctx = NewRecvStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 96, GoParserRULE_recvStmt)
+	p.EnterRule(localctx, 104, GoParserRULE_recvStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -8915,32 +9609,32 @@ func (p *GoParser) RecvStmt() (localctx IRecvStmtContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(581)
+	p.SetState(627)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(575)
+			p.SetState(621)
 			p.ExpressionList()
 		}
 		{
-			p.SetState(576)
+			p.SetState(622)
 			p.Match(GoParserASSIGN)
 		}
 
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 57, p.GetParserRuleContext()) == 2 {
+	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 63, p.GetParserRuleContext()) == 2 {
 		{
-			p.SetState(578)
+			p.SetState(624)
 			p.IdentifierList()
 		}
 		{
-			p.SetState(579)
+			p.SetState(625)
 			p.Match(GoParserDECLARE_ASSIGN)
 		}
 
 	}
 	{
-		p.SetState(583)
+		p.SetState(629)
 
 		var _x = p.expression(0)
 
@@ -9081,7 +9775,7 @@ func (p *GoParser) ForStmt() (localctx IForStmtContext) {
 	_ = this
 
 	localctx = NewForStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 98, GoParserRULE_forStmt)
+	p.EnterRule(localctx, 106, GoParserRULE_forStmt)
 	var _la int
 
 	defer func() {
@@ -9102,20 +9796,20 @@ func (p *GoParser) ForStmt() (localctx IForStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(585)
+		p.SetState(631)
 		p.Match(GoParserFOR)
 	}
-	p.SetState(593)
+	p.SetState(639)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 60, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(587)
+		p.SetState(633)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(586)
+				p.SetState(632)
 				p.expression(0)
 			}
 
@@ -9123,18 +9817,18 @@ func (p *GoParser) ForStmt() (localctx IForStmtContext) {
 
 	case 2:
 		{
-			p.SetState(589)
+			p.SetState(635)
 			p.ForClause()
 		}
 
 	case 3:
-		p.SetState(591)
+		p.SetState(637)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserRANGE-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(590)
+				p.SetState(636)
 				p.RangeClause()
 			}
 
@@ -9142,7 +9836,7 @@ func (p *GoParser) ForStmt() (localctx IForStmtContext) {
 
 	}
 	{
-		p.SetState(595)
+		p.SetState(641)
 		p.Block()
 	}
 
@@ -9332,7 +10026,7 @@ func (p *GoParser) ForClause() (localctx IForClauseContext) {
 	_ = this
 
 	localctx = NewForClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 100, GoParserRULE_forClause)
+	p.EnterRule(localctx, 108, GoParserRULE_forClause)
 	var _la int
 
 	defer func() {
@@ -9352,12 +10046,12 @@ func (p *GoParser) ForClause() (localctx IForClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(598)
+	p.SetState(644)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 61, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(597)
+			p.SetState(643)
 
 			var _x = p.SimpleStmt()
 
@@ -9366,30 +10060,30 @@ func (p *GoParser) ForClause() (localctx IForClauseContext) {
 
 	}
 	{
-		p.SetState(600)
+		p.SetState(646)
 		p.Eos()
 	}
-	p.SetState(602)
+	p.SetState(648)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 62, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(601)
+			p.SetState(647)
 			p.expression(0)
 		}
 
 	}
 	{
-		p.SetState(604)
+		p.SetState(650)
 		p.Eos()
 	}
-	p.SetState(606)
+	p.SetState(652)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 		{
-			p.SetState(605)
+			p.SetState(651)
 
 			var _x = p.SimpleStmt()
 
@@ -9524,7 +10218,7 @@ func (p *GoParser) RangeClause() (localctx IRangeClauseContext) {
 	_ = this
 
 	localctx = NewRangeClauseContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 102, GoParserRULE_rangeClause)
+	p.EnterRule(localctx, 110, GoParserRULE_rangeClause)
 
 	defer func() {
 		p.ExitRule()
@@ -9543,36 +10237,36 @@ func (p *GoParser) RangeClause() (localctx IRangeClauseContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(614)
+	p.SetState(660)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(608)
+			p.SetState(654)
 			p.ExpressionList()
 		}
 		{
-			p.SetState(609)
+			p.SetState(655)
 			p.Match(GoParserASSIGN)
 		}
 
-	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 64, p.GetParserRuleContext()) == 2 {
+	} else if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) == 2 {
 		{
-			p.SetState(611)
+			p.SetState(657)
 			p.IdentifierList()
 		}
 		{
-			p.SetState(612)
+			p.SetState(658)
 			p.Match(GoParserDECLARE_ASSIGN)
 		}
 
 	}
 	{
-		p.SetState(616)
+		p.SetState(662)
 		p.Match(GoParserRANGE)
 	}
 	{
-		p.SetState(617)
+		p.SetState(663)
 		p.expression(0)
 	}
 
@@ -9662,7 +10356,7 @@ func (p *GoParser) GoStmt() (localctx IGoStmtContext) {
 	_ = this
 
 	localctx = NewGoStmtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 104, GoParserRULE_goStmt)
+	p.EnterRule(localctx, 112, GoParserRULE_goStmt)
 
 	defer func() {
 		p.ExitRule()
@@ -9682,11 +10376,11 @@ func (p *GoParser) GoStmt() (localctx IGoStmtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(619)
+		p.SetState(665)
 		p.Match(GoParserGO)
 	}
 	{
-		p.SetState(620)
+		p.SetState(666)
 		p.expression(0)
 	}
 
@@ -9747,6 +10441,22 @@ func (s *Type_Context) TypeName() ITypeNameContext {
 	return t.(ITypeNameContext)
 }
 
+func (s *Type_Context) TypeArgs() ITypeArgsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeArgsContext)
+}
+
 func (s *Type_Context) TypeLit() ITypeLitContext {
 	var t antlr.RuleContext
 	for _, ctx := range s.GetChildren() {
@@ -9812,7 +10522,7 @@ func (p *GoParser) Type_() (localctx IType_Context) {
 	_ = this
 
 	localctx = NewType_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 106, GoParserRULE_type_)
+	p.EnterRule(localctx, 114, GoParserRULE_type_)
 
 	defer func() {
 		p.ExitRule()
@@ -9830,36 +10540,46 @@ func (p *GoParser) Type_() (localctx IType_Context) {
 		}
 	}()
 
-	p.SetState(628)
+	p.SetState(677)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(622)
+			p.SetState(668)
 			p.TypeName()
 		}
+		p.SetState(670)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(669)
+				p.TypeArgs()
+			}
+
+		}
 
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(623)
+			p.SetState(672)
 			p.TypeLit()
 		}
 
 	case GoParserL_PAREN:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(624)
+			p.SetState(673)
 			p.Match(GoParserL_PAREN)
 		}
 		{
-			p.SetState(625)
+			p.SetState(674)
 			p.Type_()
 		}
 		{
-			p.SetState(626)
+			p.SetState(675)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -9870,6 +10590,144 @@ func (p *GoParser) Type_() (localctx IType_Context) {
 	return localctx
 }
 
+// ITypeArgsContext is an interface to support dynamic dispatch.
+type ITypeArgsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypeArgsContext differentiates from other interfaces.
+	IsTypeArgsContext()
+}
+
+type TypeArgsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypeArgsContext() *TypeArgsContext {
+	var p = new(TypeArgsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = GoParserRULE_typeArgs
+	return p
+}
+
+func (*TypeArgsContext) IsTypeArgsContext() {}
+
+func NewTypeArgsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypeArgsContext {
+	var p = new(TypeArgsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = GoParserRULE_typeArgs
+
+	return p
+}
+
+func (s *TypeArgsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypeArgsContext) L_BRACKET() antlr.TerminalNode {
+	return s.GetToken(GoParserL_BRACKET, 0)
+}
+
+func (s *TypeArgsContext) TypeList() ITypeListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeListContext)
+}
+
+func (s *TypeArgsContext) R_BRACKET() antlr.TerminalNode {
+	return s.GetToken(GoParserR_BRACKET, 0)
+}
+
+func (s *TypeArgsContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(GoParserCOMMA, 0)
+}
+
+func (s *TypeArgsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypeArgsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypeArgsContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.EnterTypeArgs(s)
+	}
+}
+
+func (s *TypeArgsContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(GoParserListener); ok {
+		listenerT.ExitTypeArgs(s)
+	}
+}
+
+func (p *GoParser) TypeArgs() (localctx ITypeArgsContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypeArgsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, GoParserRULE_typeArgs)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(679)
+		p.Match(GoParserL_BRACKET)
+	}
+	{
+		p.SetState(680)
+		p.TypeList()
+	}
+	p.SetState(682)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == GoParserCOMMA {
+		{
+			p.SetState(681)
+			p.Match(GoParserCOMMA)
+		}
+
+	}
+	{
+		p.SetState(684)
+		p.Match(GoParserR_BRACKET)
+	}
+
+	return localctx
+}
+
 // ITypeNameContext is an interface to support dynamic dispatch.
 type ITypeNameContext interface {
 	antlr.ParserRuleContext
@@ -9953,7 +10811,7 @@ func (p *GoParser) TypeName() (localctx ITypeNameContext) {
 	_ = this
 
 	localctx = NewTypeNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 108, GoParserRULE_typeName)
+	p.EnterRule(localctx, 118, GoParserRULE_typeName)
 
 	defer func() {
 		p.ExitRule()
@@ -9971,20 +10829,20 @@ func (p *GoParser) TypeName() (localctx ITypeNameContext) {
 		}
 	}()
 
-	p.SetState(632)
+	p.SetState(688)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 66, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(630)
+			p.SetState(686)
 			p.QualifiedIdent()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(631)
+			p.SetState(687)
 			p.Match(GoParserIDENTIFIER)
 		}
 
@@ -10236,7 +11094,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 	_ = this
 
 	localctx = NewTypeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 110, GoParserRULE_typeLit)
+	p.EnterRule(localctx, 120, GoParserRULE_typeLit)
 
 	defer func() {
 		p.ExitRule()
@@ -10254,14 +11112,14 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		}
 	}()
 
-	p.SetState(642)
+	p.SetState(698)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(634)
+			p.SetState(690)
 			p.ArrayType()
 		}
 
@@ -10269,7 +11127,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewDeclStructContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(635)
+			p.SetState(691)
 			p.StructType()
 		}
 
@@ -10277,7 +11135,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(636)
+			p.SetState(692)
 			p.PointerType()
 		}
 
@@ -10285,7 +11143,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(637)
+			p.SetState(693)
 			p.FunctionType()
 		}
 
@@ -10293,7 +11151,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(638)
+			p.SetState(694)
 			p.InterfaceType()
 		}
 
@@ -10301,7 +11159,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(639)
+			p.SetState(695)
 			p.SliceType()
 		}
 
@@ -10309,7 +11167,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(640)
+			p.SetState(696)
 			p.MapType()
 		}
 
@@ -10317,7 +11175,7 @@ func (p *GoParser) TypeLit() (localctx ITypeLitContext) {
 		localctx = NewIgnContext(p, localctx)
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(641)
+			p.SetState(697)
 			p.ChannelType()
 		}
 
@@ -10429,7 +11287,7 @@ func (p *GoParser) ArrayType() (localctx IArrayTypeContext) {
 	_ = this
 
 	localctx = NewArrayTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 112, GoParserRULE_arrayType)
+	p.EnterRule(localctx, 122, GoParserRULE_arrayType)
 
 	defer func() {
 		p.ExitRule()
@@ -10449,19 +11307,19 @@ func (p *GoParser) ArrayType() (localctx IArrayTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(644)
+		p.SetState(700)
 		p.Match(GoParserL_BRACKET)
 	}
 	{
-		p.SetState(645)
+		p.SetState(701)
 		p.ArrayLength()
 	}
 	{
-		p.SetState(646)
+		p.SetState(702)
 		p.Match(GoParserR_BRACKET)
 	}
 	{
-		p.SetState(647)
+		p.SetState(703)
 		p.ElementType()
 	}
 
@@ -10547,7 +11405,7 @@ func (p *GoParser) ArrayLength() (localctx IArrayLengthContext) {
 	_ = this
 
 	localctx = NewArrayLengthContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 114, GoParserRULE_arrayLength)
+	p.EnterRule(localctx, 124, GoParserRULE_arrayLength)
 
 	defer func() {
 		p.ExitRule()
@@ -10567,7 +11425,7 @@ func (p *GoParser) ArrayLength() (localctx IArrayLengthContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(649)
+		p.SetState(705)
 		p.expression(0)
 	}
 
@@ -10653,7 +11511,7 @@ func (p *GoParser) ElementType() (localctx IElementTypeContext) {
 	_ = this
 
 	localctx = NewElementTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 116, GoParserRULE_elementType)
+	p.EnterRule(localctx, 126, GoParserRULE_elementType)
 
 	defer func() {
 		p.ExitRule()
@@ -10673,7 +11531,7 @@ func (p *GoParser) ElementType() (localctx IElementTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(651)
+		p.SetState(707)
 		p.Type_()
 	}
 
@@ -10763,7 +11621,7 @@ func (p *GoParser) PointerType() (localctx IPointerTypeContext) {
 	_ = this
 
 	localctx = NewPointerTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 118, GoParserRULE_pointerType)
+	p.EnterRule(localctx, 128, GoParserRULE_pointerType)
 
 	defer func() {
 		p.ExitRule()
@@ -10783,11 +11641,11 @@ func (p *GoParser) PointerType() (localctx IPointerTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(653)
+		p.SetState(709)
 		p.Match(GoParserSTAR)
 	}
 	{
-		p.SetState(654)
+		p.SetState(710)
 		p.Type_()
 	}
 
@@ -10926,20 +11784,20 @@ func (s *InterfaceTypeContext) MethodSpec(i int) IMethodSpecContext {
 	return t.(IMethodSpecContext)
 }
 
-func (s *InterfaceTypeContext) AllTypeName() []ITypeNameContext {
+func (s *InterfaceTypeContext) AllTypeElement() []ITypeElementContext {
 	children := s.GetChildren()
 	len := 0
 	for _, ctx := range children {
-		if _, ok := ctx.(ITypeNameContext); ok {
+		if _, ok := ctx.(ITypeElementContext); ok {
 			len++
 		}
 	}
 
-	tst := make([]ITypeNameContext, len)
+	tst := make([]ITypeElementContext, len)
 	i := 0
 	for _, ctx := range children {
-		if t, ok := ctx.(ITypeNameContext); ok {
-			tst[i] = t.(ITypeNameContext)
+		if t, ok := ctx.(ITypeElementContext); ok {
+			tst[i] = t.(ITypeElementContext)
 			i++
 		}
 	}
@@ -10947,11 +11805,11 @@ func (s *InterfaceTypeContext) AllTypeName() []ITypeNameContext {
 	return tst
 }
 
-func (s *InterfaceTypeContext) TypeName(i int) ITypeNameContext {
+func (s *InterfaceTypeContext) TypeElement(i int) ITypeElementContext {
 	var t antlr.RuleContext
 	j := 0
 	for _, ctx := range s.GetChildren() {
-		if _, ok := ctx.(ITypeNameContext); ok {
+		if _, ok := ctx.(ITypeElementContext); ok {
 			if j == i {
 				t = ctx.(antlr.RuleContext)
 				break
@@ -10964,7 +11822,7 @@ func (s *InterfaceTypeContext) TypeName(i int) ITypeNameContext {
 		return nil
 	}
 
-	return t.(ITypeNameContext)
+	return t.(ITypeElementContext)
 }
 
 func (s *InterfaceTypeContext) GetRuleContext() antlr.RuleContext {
@@ -10992,7 +11850,7 @@ func (p *GoParser) InterfaceType() (localctx IInterfaceTypeContext) {
 	_ = this
 
 	localctx = NewInterfaceTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 120, GoParserRULE_interfaceType)
+	p.EnterRule(localctx, 130, GoParserRULE_interfaceType)
 	var _la int
 
 	defer func() {
@@ -11013,45 +11871,45 @@ func (p *GoParser) InterfaceType() (localctx IInterfaceTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(656)
+		p.SetState(712)
 		p.Match(GoParserINTERFACE)
 	}
 	{
-		p.SetState(657)
+		p.SetState(713)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(666)
+	p.SetState(722)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for _la == GoParserIDENTIFIER {
-		p.SetState(660)
+	for (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-61)&-(0x1f+1)) == 0 && ((1<<uint((_la-61)))&((1<<(GoParserSTAR-61))|(1<<(GoParserRECEIVE-61))|(1<<(GoParserUNDERLYING-61)))) != 0) {
+		p.SetState(716)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 68, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 76, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(658)
+				p.SetState(714)
 				p.MethodSpec()
 			}
 
 		case 2:
 			{
-				p.SetState(659)
-				p.TypeName()
+				p.SetState(715)
+				p.TypeElement()
 			}
 
 		}
 		{
-			p.SetState(662)
+			p.SetState(718)
 			p.Eos()
 		}
 
-		p.SetState(668)
+		p.SetState(724)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(669)
+		p.SetState(725)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -11145,7 +12003,7 @@ func (p *GoParser) SliceType() (localctx ISliceTypeContext) {
 	_ = this
 
 	localctx = NewSliceTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 122, GoParserRULE_sliceType)
+	p.EnterRule(localctx, 132, GoParserRULE_sliceType)
 
 	defer func() {
 		p.ExitRule()
@@ -11165,15 +12023,15 @@ func (p *GoParser) SliceType() (localctx ISliceTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(671)
+		p.SetState(727)
 		p.Match(GoParserL_BRACKET)
 	}
 	{
-		p.SetState(672)
+		p.SetState(728)
 		p.Match(GoParserR_BRACKET)
 	}
 	{
-		p.SetState(673)
+		p.SetState(729)
 		p.ElementType()
 	}
 
@@ -11287,7 +12145,7 @@ func (p *GoParser) MapType() (localctx IMapTypeContext) {
 	_ = this
 
 	localctx = NewMapTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 124, GoParserRULE_mapType)
+	p.EnterRule(localctx, 134, GoParserRULE_mapType)
 
 	defer func() {
 		p.ExitRule()
@@ -11307,23 +12165,23 @@ func (p *GoParser) MapType() (localctx IMapTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(675)
+		p.SetState(731)
 		p.Match(GoParserMAP)
 	}
 	{
-		p.SetState(676)
+		p.SetState(732)
 		p.Match(GoParserL_BRACKET)
 	}
 	{
-		p.SetState(677)
+		p.SetState(733)
 		p.Type_()
 	}
 	{
-		p.SetState(678)
+		p.SetState(734)
 		p.Match(GoParserR_BRACKET)
 	}
 	{
-		p.SetState(679)
+		p.SetState(735)
 		p.ElementType()
 	}
 
@@ -11417,7 +12275,7 @@ func (p *GoParser) ChannelType() (localctx IChannelTypeContext) {
 	_ = this
 
 	localctx = NewChannelTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 126, GoParserRULE_channelType)
+	p.EnterRule(localctx, 136, GoParserRULE_channelType)
 
 	defer func() {
 		p.ExitRule()
@@ -11436,38 +12294,38 @@ func (p *GoParser) ChannelType() (localctx IChannelTypeContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(686)
+	p.SetState(742)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 70, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(681)
+			p.SetState(737)
 			p.Match(GoParserCHAN)
 		}
 
 	case 2:
 		{
-			p.SetState(682)
+			p.SetState(738)
 			p.Match(GoParserCHAN)
 		}
 		{
-			p.SetState(683)
+			p.SetState(739)
 			p.Match(GoParserRECEIVE)
 		}
 
 	case 3:
 		{
-			p.SetState(684)
+			p.SetState(740)
 			p.Match(GoParserRECEIVE)
 		}
 		{
-			p.SetState(685)
+			p.SetState(741)
 			p.Match(GoParserCHAN)
 		}
 
 	}
 	{
-		p.SetState(688)
+		p.SetState(744)
 		p.ElementType()
 	}
 
@@ -11573,7 +12431,7 @@ func (p *GoParser) MethodSpec() (localctx IMethodSpecContext) {
 	_ = this
 
 	localctx = NewMethodSpecContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 128, GoParserRULE_methodSpec)
+	p.EnterRule(localctx, 138, GoParserRULE_methodSpec)
 
 	defer func() {
 		p.ExitRule()
@@ -11591,32 +12449,32 @@ func (p *GoParser) MethodSpec() (localctx IMethodSpecContext) {
 		}
 	}()
 
-	p.SetState(696)
+	p.SetState(752)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 71, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(690)
+			p.SetState(746)
 			p.Match(GoParserIDENTIFIER)
 		}
 		{
-			p.SetState(691)
+			p.SetState(747)
 			p.Parameters()
 		}
 		{
-			p.SetState(692)
+			p.SetState(748)
 			p.Result()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(694)
+			p.SetState(750)
 			p.Match(GoParserIDENTIFIER)
 		}
 		{
-			p.SetState(695)
+			p.SetState(751)
 			p.Parameters()
 		}
 
@@ -11708,7 +12566,7 @@ func (p *GoParser) FunctionType() (localctx IFunctionTypeContext) {
 	_ = this
 
 	localctx = NewFunctionTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 130, GoParserRULE_functionType)
+	p.EnterRule(localctx, 140, GoParserRULE_functionType)
 
 	defer func() {
 		p.ExitRule()
@@ -11728,11 +12586,11 @@ func (p *GoParser) FunctionType() (localctx IFunctionTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(698)
+		p.SetState(754)
 		p.Match(GoParserFUNC)
 	}
 	{
-		p.SetState(699)
+		p.SetState(755)
 		p.Signature()
 	}
 
@@ -11902,7 +12760,7 @@ func (p *GoParser) Signature() (localctx ISignatureContext) {
 	_ = this
 
 	localctx = NewSignatureContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 132, GoParserRULE_signature)
+	p.EnterRule(localctx, 142, GoParserRULE_signature)
 
 	defer func() {
 		p.ExitRule()
@@ -11920,18 +12778,18 @@ func (p *GoParser) Signature() (localctx ISignatureContext) {
 		}
 	}()
 
-	p.SetState(705)
+	p.SetState(761)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 72, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) {
 	case 1:
 		localctx = NewParamResultContext(p, localctx)
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(701)
+			p.SetState(757)
 			p.Parameters()
 		}
 		{
-			p.SetState(702)
+			p.SetState(758)
 			p.Result()
 		}
 
@@ -11939,7 +12797,7 @@ func (p *GoParser) Signature() (localctx ISignatureContext) {
 		localctx = NewParamSimpleContext(p, localctx)
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(704)
+			p.SetState(760)
 			p.Parameters()
 		}
 
@@ -12043,7 +12901,7 @@ func (p *GoParser) Result() (localctx IResultContext) {
 	_ = this
 
 	localctx = NewResultContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 134, GoParserRULE_result)
+	p.EnterRule(localctx, 144, GoParserRULE_result)
 
 	defer func() {
 		p.ExitRule()
@@ -12061,20 +12919,20 @@ func (p *GoParser) Result() (localctx IResultContext) {
 		}
 	}()
 
-	p.SetState(709)
+	p.SetState(765)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 73, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(707)
+			p.SetState(763)
 			p.Parameters()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(708)
+			p.SetState(764)
 			p.Type_()
 		}
 
@@ -12203,7 +13061,7 @@ func (p *GoParser) Parameters() (localctx IParametersContext) {
 	_ = this
 
 	localctx = NewParametersContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 136, GoParserRULE_parameters)
+	p.EnterRule(localctx, 146, GoParserRULE_parameters)
 	var _la int
 
 	defer func() {
@@ -12226,45 +13084,45 @@ func (p *GoParser) Parameters() (localctx IParametersContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(711)
+		p.SetState(767)
 		p.Match(GoParserL_PAREN)
 	}
-	p.SetState(723)
+	p.SetState(779)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<GoParserFUNC)|(1<<GoParserINTERFACE)|(1<<GoParserMAP)|(1<<GoParserSTRUCT)|(1<<GoParserCHAN)|(1<<GoParserIDENTIFIER)|(1<<GoParserL_PAREN))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(GoParserL_BRACKET-32))|(1<<(GoParserELLIPSIS-32))|(1<<(GoParserSTAR-32))|(1<<(GoParserRECEIVE-32)))) != 0) {
 		{
-			p.SetState(712)
+			p.SetState(768)
 			p.ParameterDecl()
 		}
-		p.SetState(717)
+		p.SetState(773)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext())
 
 		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 			if _alt == 1 {
 				{
-					p.SetState(713)
+					p.SetState(769)
 					p.Match(GoParserCOMMA)
 				}
 				{
-					p.SetState(714)
+					p.SetState(770)
 					p.ParameterDecl()
 				}
 
 			}
-			p.SetState(719)
+			p.SetState(775)
 			p.GetErrorHandler().Sync(p)
-			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 74, p.GetParserRuleContext())
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext())
 		}
-		p.SetState(721)
+		p.SetState(777)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == GoParserCOMMA {
 			{
-				p.SetState(720)
+				p.SetState(776)
 				p.Match(GoParserCOMMA)
 			}
 
@@ -12272,7 +13130,7 @@ func (p *GoParser) Parameters() (localctx IParametersContext) {
 
 	}
 	{
-		p.SetState(725)
+		p.SetState(781)
 		p.Match(GoParserR_PAREN)
 	}
 
@@ -12378,7 +13236,7 @@ func (p *GoParser) ParameterDecl() (localctx IParameterDeclContext) {
 	_ = this
 
 	localctx = NewParameterDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 138, GoParserRULE_parameterDecl)
+	p.EnterRule(localctx, 148, GoParserRULE_parameterDecl)
 	var _la int
 
 	defer func() {
@@ -12398,29 +13256,29 @@ func (p *GoParser) ParameterDecl() (localctx IParameterDeclContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(728)
+	p.SetState(784)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 77, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 85, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(727)
+			p.SetState(783)
 			p.IdentifierList()
 		}
 
 	}
-	p.SetState(731)
+	p.SetState(787)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == GoParserELLIPSIS {
 		{
-			p.SetState(730)
+			p.SetState(786)
 			p.Match(GoParserELLIPSIS)
 		}
 
 	}
 	{
-		p.SetState(733)
+		p.SetState(789)
 		p.Type_()
 	}
 
@@ -12683,8 +13541,8 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 	localctx = NewExpressionContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IExpressionContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 140
-	p.EnterRecursionRule(localctx, 140, GoParserRULE_expression, _p)
+	_startState := 150
+	p.EnterRecursionRule(localctx, 150, GoParserRULE_expression, _p)
 	var _la int
 
 	defer func() {
@@ -12706,18 +13564,18 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(739)
+	p.SetState(795)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 79, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(736)
+			p.SetState(792)
 			p.primaryExpr(0)
 		}
 
 	case 2:
 		{
-			p.SetState(737)
+			p.SetState(793)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -12735,15 +13593,15 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 			}
 		}
 		{
-			p.SetState(738)
+			p.SetState(794)
 			p.expression(6)
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(758)
+	p.SetState(814)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -12751,19 +13609,19 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 				p.TriggerExitRuleEvent()
 			}
 			_prevctx = localctx
-			p.SetState(756)
+			p.SetState(812)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 88, p.GetParserRuleContext()) {
 			case 1:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, GoParserRULE_expression)
-				p.SetState(741)
+				p.SetState(797)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
 				}
 				{
-					p.SetState(742)
+					p.SetState(798)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -12781,20 +13639,20 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(743)
+					p.SetState(799)
 					p.expression(6)
 				}
 
 			case 2:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, GoParserRULE_expression)
-				p.SetState(744)
+				p.SetState(800)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
 				}
 				{
-					p.SetState(745)
+					p.SetState(801)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -12812,20 +13670,20 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(746)
+					p.SetState(802)
 					p.expression(5)
 				}
 
 			case 3:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, GoParserRULE_expression)
-				p.SetState(747)
+				p.SetState(803)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
 				}
 				{
-					p.SetState(748)
+					p.SetState(804)
 
 					var _lt = p.GetTokenStream().LT(1)
 
@@ -12843,50 +13701,50 @@ func (p *GoParser) expression(_p int) (localctx IExpressionContext) {
 					}
 				}
 				{
-					p.SetState(749)
+					p.SetState(805)
 					p.expression(4)
 				}
 
 			case 4:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, GoParserRULE_expression)
-				p.SetState(750)
+				p.SetState(806)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
 				}
 				{
-					p.SetState(751)
+					p.SetState(807)
 					p.Match(GoParserLOGICAL_AND)
 				}
 				{
-					p.SetState(752)
+					p.SetState(808)
 					p.expression(3)
 				}
 
 			case 5:
 				localctx = NewExpressionContext(p, _parentctx, _parentState)
 				p.PushNewRecursionContext(localctx, _startState, GoParserRULE_expression)
-				p.SetState(753)
+				p.SetState(809)
 
 				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 				}
 				{
-					p.SetState(754)
+					p.SetState(810)
 					p.Match(GoParserLOGICAL_OR)
 				}
 				{
-					p.SetState(755)
+					p.SetState(811)
 					p.expression(2)
 				}
 
 			}
 
 		}
-		p.SetState(760)
+		p.SetState(816)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 81, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13099,8 +13957,8 @@ func (p *GoParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 	localctx = NewPrimaryExprContext(p, p.GetParserRuleContext(), _parentState)
 	var _prevctx IPrimaryExprContext = localctx
 	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
-	_startState := 142
-	p.EnterRecursionRule(localctx, 142, GoParserRULE_primaryExpr, _p)
+	_startState := 152
+	p.EnterRecursionRule(localctx, 152, GoParserRULE_primaryExpr, _p)
 
 	defer func() {
 		p.UnrollRecursionContexts(_parentctx)
@@ -13121,32 +13979,32 @@ func (p *GoParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 	var _alt int
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(765)
+	p.SetState(821)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 82, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(762)
+			p.SetState(818)
 			p.Operand()
 		}
 
 	case 2:
 		{
-			p.SetState(763)
+			p.SetState(819)
 			p.Conversion()
 		}
 
 	case 3:
 		{
-			p.SetState(764)
+			p.SetState(820)
 			p.MethodExpr()
 		}
 
 	}
 	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
-	p.SetState(778)
+	p.SetState(834)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
@@ -13156,54 +14014,54 @@ func (p *GoParser) primaryExpr(_p int) (localctx IPrimaryExprContext) {
 			_prevctx = localctx
 			localctx = NewPrimaryExprContext(p, _parentctx, _parentState)
 			p.PushNewRecursionContext(localctx, _startState, GoParserRULE_primaryExpr)
-			p.SetState(767)
+			p.SetState(823)
 
 			if !(p.Precpred(p.GetParserRuleContext(), 1)) {
 				panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
 			}
-			p.SetState(774)
+			p.SetState(830)
 			p.GetErrorHandler().Sync(p)
-			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 83, p.GetParserRuleContext()) {
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 91, p.GetParserRuleContext()) {
 			case 1:
 				{
-					p.SetState(768)
+					p.SetState(824)
 					p.Match(GoParserDOT)
 				}
 				{
-					p.SetState(769)
+					p.SetState(825)
 					p.Match(GoParserIDENTIFIER)
 				}
 
 			case 2:
 				{
-					p.SetState(770)
+					p.SetState(826)
 					p.Index()
 				}
 
 			case 3:
 				{
-					p.SetState(771)
+					p.SetState(827)
 					p.Slice_()
 				}
 
 			case 4:
 				{
-					p.SetState(772)
+					p.SetState(828)
 					p.TypeAssertion()
 				}
 
 			case 5:
 				{
-					p.SetState(773)
+					p.SetState(829)
 					p.Arguments()
 				}
 
 			}
 
 		}
-		p.SetState(780)
+		p.SetState(836)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 84, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -13316,7 +14174,7 @@ func (p *GoParser) Conversion() (localctx IConversionContext) {
 	_ = this
 
 	localctx = NewConversionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 144, GoParserRULE_conversion)
+	p.EnterRule(localctx, 154, GoParserRULE_conversion)
 	var _la int
 
 	defer func() {
@@ -13337,30 +14195,30 @@ func (p *GoParser) Conversion() (localctx IConversionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(781)
+		p.SetState(837)
 		p.NonNamedType()
 	}
 	{
-		p.SetState(782)
+		p.SetState(838)
 		p.Match(GoParserL_PAREN)
 	}
 	{
-		p.SetState(783)
+		p.SetState(839)
 		p.expression(0)
 	}
-	p.SetState(785)
+	p.SetState(841)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == GoParserCOMMA {
 		{
-			p.SetState(784)
+			p.SetState(840)
 			p.Match(GoParserCOMMA)
 		}
 
 	}
 	{
-		p.SetState(787)
+		p.SetState(843)
 		p.Match(GoParserR_PAREN)
 	}
 
@@ -13470,7 +14328,7 @@ func (p *GoParser) NonNamedType() (localctx INonNamedTypeContext) {
 	_ = this
 
 	localctx = NewNonNamedTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 146, GoParserRULE_nonNamedType)
+	p.EnterRule(localctx, 156, GoParserRULE_nonNamedType)
 
 	defer func() {
 		p.ExitRule()
@@ -13488,29 +14346,29 @@ func (p *GoParser) NonNamedType() (localctx INonNamedTypeContext) {
 		}
 	}()
 
-	p.SetState(794)
+	p.SetState(850)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserL_BRACKET, GoParserSTAR, GoParserRECEIVE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(789)
+			p.SetState(845)
 			p.TypeLit()
 		}
 
 	case GoParserL_PAREN:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(790)
+			p.SetState(846)
 			p.Match(GoParserL_PAREN)
 		}
 		{
-			p.SetState(791)
+			p.SetState(847)
 			p.NonNamedType()
 		}
 		{
-			p.SetState(792)
+			p.SetState(848)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -13591,6 +14449,22 @@ func (s *OperandContext) OperandName() IOperandNameContext {
 	return t.(IOperandNameContext)
 }
 
+func (s *OperandContext) TypeArgs() ITypeArgsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeArgsContext)
+}
+
 func (s *OperandContext) L_PAREN() antlr.TerminalNode {
 	return s.GetToken(GoParserL_PAREN, 0)
 }
@@ -13640,7 +14514,7 @@ func (p *GoParser) Operand() (localctx IOperandContext) {
 	_ = this
 
 	localctx = NewOperandContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 148, GoParserRULE_operand)
+	p.EnterRule(localctx, 158, GoParserRULE_operand)
 
 	defer func() {
 		p.ExitRule()
@@ -13658,35 +14532,45 @@ func (p *GoParser) Operand() (localctx IOperandContext) {
 		}
 	}()
 
-	p.SetState(802)
+	p.SetState(861)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 87, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 96, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(796)
+			p.SetState(852)
 			p.Literal()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(797)
+			p.SetState(853)
 			p.OperandName()
 		}
+		p.SetState(855)
+		p.GetErrorHandler().Sync(p)
+
+		if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext()) == 1 {
+			{
+				p.SetState(854)
+				p.TypeArgs()
+			}
+
+		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(798)
+			p.SetState(857)
 			p.Match(GoParserL_PAREN)
 		}
 		{
-			p.SetState(799)
+			p.SetState(858)
 			p.expression(0)
 		}
 		{
-			p.SetState(800)
+			p.SetState(859)
 			p.Match(GoParserR_PAREN)
 		}
 
@@ -13806,7 +14690,7 @@ func (p *GoParser) Literal() (localctx ILiteralContext) {
 	_ = this
 
 	localctx = NewLiteralContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 150, GoParserRULE_literal)
+	p.EnterRule(localctx, 160, GoParserRULE_literal)
 
 	defer func() {
 		p.ExitRule()
@@ -13824,28 +14708,28 @@ func (p *GoParser) Literal() (localctx ILiteralContext) {
 		}
 	}()
 
-	p.SetState(807)
+	p.SetState(866)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserNIL_LIT, GoParserDECIMAL_LIT, GoParserBINARY_LIT, GoParserOCTAL_LIT, GoParserHEX_LIT, GoParserFLOAT_LIT, GoParserIMAGINARY_LIT, GoParserRUNE_LIT, GoParserRAW_STRING_LIT, GoParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(804)
+			p.SetState(863)
 			p.BasicLit()
 		}
 
 	case GoParserMAP, GoParserSTRUCT, GoParserIDENTIFIER, GoParserL_BRACKET:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(805)
+			p.SetState(864)
 			p.CompositeLit()
 		}
 
 	case GoParserFUNC:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(806)
+			p.SetState(865)
 			p.FunctionLit()
 		}
 
@@ -13959,7 +14843,7 @@ func (p *GoParser) BasicLit() (localctx IBasicLitContext) {
 	_ = this
 
 	localctx = NewBasicLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 152, GoParserRULE_basicLit)
+	p.EnterRule(localctx, 162, GoParserRULE_basicLit)
 
 	defer func() {
 		p.ExitRule()
@@ -13977,35 +14861,35 @@ func (p *GoParser) BasicLit() (localctx IBasicLitContext) {
 		}
 	}()
 
-	p.SetState(813)
+	p.SetState(872)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserNIL_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(809)
+			p.SetState(868)
 			p.Match(GoParserNIL_LIT)
 		}
 
 	case GoParserDECIMAL_LIT, GoParserBINARY_LIT, GoParserOCTAL_LIT, GoParserHEX_LIT, GoParserIMAGINARY_LIT, GoParserRUNE_LIT:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(810)
+			p.SetState(869)
 			p.Integer()
 		}
 
 	case GoParserRAW_STRING_LIT, GoParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(811)
+			p.SetState(870)
 			p.String_()
 		}
 
 	case GoParserFLOAT_LIT:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(812)
+			p.SetState(871)
 			p.Match(GoParserFLOAT_LIT)
 		}
 
@@ -14103,7 +14987,7 @@ func (p *GoParser) Integer() (localctx IIntegerContext) {
 	_ = this
 
 	localctx = NewIntegerContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 154, GoParserRULE_integer)
+	p.EnterRule(localctx, 164, GoParserRULE_integer)
 	var _la int
 
 	defer func() {
@@ -14124,10 +15008,10 @@ func (p *GoParser) Integer() (localctx IIntegerContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(815)
+		p.SetState(874)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(((_la-64)&-(0x1f+1)) == 0 && ((1<<uint((_la-64)))&((1<<(GoParserDECIMAL_LIT-64))|(1<<(GoParserBINARY_LIT-64))|(1<<(GoParserOCTAL_LIT-64))|(1<<(GoParserHEX_LIT-64))|(1<<(GoParserIMAGINARY_LIT-64))|(1<<(GoParserRUNE_LIT-64)))) != 0) {
+		if !(((_la-65)&-(0x1f+1)) == 0 && ((1<<uint((_la-65)))&((1<<(GoParserDECIMAL_LIT-65))|(1<<(GoParserBINARY_LIT-65))|(1<<(GoParserOCTAL_LIT-65))|(1<<(GoParserHEX_LIT-65))|(1<<(GoParserIMAGINARY_LIT-65))|(1<<(GoParserRUNE_LIT-65)))) != 0) {
 			p.GetErrorHandler().RecoverInline(p)
 		} else {
 			p.GetErrorHandler().ReportMatch(p)
@@ -14205,7 +15089,7 @@ func (p *GoParser) OperandName() (localctx IOperandNameContext) {
 	_ = this
 
 	localctx = NewOperandNameContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 156, GoParserRULE_operandName)
+	p.EnterRule(localctx, 166, GoParserRULE_operandName)
 
 	defer func() {
 		p.ExitRule()
@@ -14225,7 +15109,7 @@ func (p *GoParser) OperandName() (localctx IOperandNameContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(817)
+		p.SetState(876)
 		p.Match(GoParserIDENTIFIER)
 	}
 
@@ -14307,7 +15191,7 @@ func (p *GoParser) QualifiedIdent() (localctx IQualifiedIdentContext) {
 	_ = this
 
 	localctx = NewQualifiedIdentContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 158, GoParserRULE_qualifiedIdent)
+	p.EnterRule(localctx, 168, GoParserRULE_qualifiedIdent)
 
 	defer func() {
 		p.ExitRule()
@@ -14327,15 +15211,15 @@ func (p *GoParser) QualifiedIdent() (localctx IQualifiedIdentContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(819)
+		p.SetState(878)
 		p.Match(GoParserIDENTIFIER)
 	}
 	{
-		p.SetState(820)
+		p.SetState(879)
 		p.Match(GoParserDOT)
 	}
 	{
-		p.SetState(821)
+		p.SetState(880)
 		p.Match(GoParserIDENTIFIER)
 	}
 
@@ -14437,7 +15321,7 @@ func (p *GoParser) CompositeLit() (localctx ICompositeLitContext) {
 	_ = this
 
 	localctx = NewCompositeLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 160, GoParserRULE_compositeLit)
+	p.EnterRule(localctx, 170, GoParserRULE_compositeLit)
 
 	defer func() {
 		p.ExitRule()
@@ -14457,11 +15341,11 @@ func (p *GoParser) CompositeLit() (localctx ICompositeLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(823)
+		p.SetState(882)
 		p.LiteralType()
 	}
 	{
-		p.SetState(824)
+		p.SetState(883)
 		p.LiteralValue()
 	}
 
@@ -14614,6 +15498,22 @@ func (s *LiteralTypeContext) TypeName() ITypeNameContext {
 	return t.(ITypeNameContext)
 }
 
+func (s *LiteralTypeContext) TypeArgs() ITypeArgsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeArgsContext)
+}
+
 func (s *LiteralTypeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -14639,7 +15539,8 @@ func (p *GoParser) LiteralType() (localctx ILiteralTypeContext) {
 	_ = this
 
 	localctx = NewLiteralTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 162, GoParserRULE_literalType)
+	p.EnterRule(localctx, 172, GoParserRULE_literalType)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -14657,62 +15558,73 @@ func (p *GoParser) LiteralType() (localctx ILiteralTypeContext) {
 		}
 	}()
 
-	p.SetState(835)
+	p.SetState(897)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 90, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 100, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(826)
+			p.SetState(885)
 			p.StructType()
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(827)
+			p.SetState(886)
 			p.ArrayType()
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(828)
+			p.SetState(887)
 			p.Match(GoParserL_BRACKET)
 		}
 		{
-			p.SetState(829)
+			p.SetState(888)
 			p.Match(GoParserELLIPSIS)
 		}
 		{
-			p.SetState(830)
+			p.SetState(889)
 			p.Match(GoParserR_BRACKET)
 		}
 		{
-			p.SetState(831)
+			p.SetState(890)
 			p.ElementType()
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(832)
+			p.SetState(891)
 			p.SliceType()
 		}
 
 	case 5:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(833)
+			p.SetState(892)
 			p.MapType()
 		}
 
 	case 6:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(834)
+			p.SetState(893)
 			p.TypeName()
 		}
+		p.SetState(895)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == GoParserL_BRACKET {
+			{
+				p.SetState(894)
+				p.TypeArgs()
+			}
+
+		}
 
 	}
 
@@ -14810,7 +15722,7 @@ func (p *GoParser) LiteralValue() (localctx ILiteralValueContext) {
 	_ = this
 
 	localctx = NewLiteralValueContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 164, GoParserRULE_literalValue)
+	p.EnterRule(localctx, 174, GoParserRULE_literalValue)
 	var _la int
 
 	defer func() {
@@ -14831,25 +15743,25 @@ func (p *GoParser) LiteralValue() (localctx ILiteralValueContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(837)
+		p.SetState(899)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(842)
+	p.SetState(904)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_CURLY-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 		{
-			p.SetState(838)
+			p.SetState(900)
 			p.ElementList()
 		}
-		p.SetState(840)
+		p.SetState(902)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == GoParserCOMMA {
 			{
-				p.SetState(839)
+				p.SetState(901)
 				p.Match(GoParserCOMMA)
 			}
 
@@ -14857,7 +15769,7 @@ func (p *GoParser) LiteralValue() (localctx ILiteralValueContext) {
 
 	}
 	{
-		p.SetState(844)
+		p.SetState(906)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -14976,7 +15888,7 @@ func (p *GoParser) ElementList() (localctx IElementListContext) {
 	_ = this
 
 	localctx = NewElementListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 166, GoParserRULE_elementList)
+	p.EnterRule(localctx, 176, GoParserRULE_elementList)
 
 	defer func() {
 		p.ExitRule()
@@ -14998,28 +15910,28 @@ func (p *GoParser) ElementList() (localctx IElementListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(846)
+		p.SetState(908)
 		p.KeyedElement()
 	}
-	p.SetState(851)
+	p.SetState(913)
 	p.GetErrorHandler().Sync(p)
-	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext())
 
 	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
 		if _alt == 1 {
 			{
-				p.SetState(847)
+				p.SetState(909)
 				p.Match(GoParserCOMMA)
 			}
 			{
-				p.SetState(848)
+				p.SetState(910)
 				p.KeyedElement()
 			}
 
 		}
-		p.SetState(853)
+		p.SetState(915)
 		p.GetErrorHandler().Sync(p)
-		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 93, p.GetParserRuleContext())
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext())
 	}
 
 	return localctx
@@ -15124,7 +16036,7 @@ func (p *GoParser) KeyedElement() (localctx IKeyedElementContext) {
 	_ = this
 
 	localctx = NewKeyedElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 168, GoParserRULE_keyedElement)
+	p.EnterRule(localctx, 178, GoParserRULE_keyedElement)
 
 	defer func() {
 		p.ExitRule()
@@ -15143,22 +16055,22 @@ func (p *GoParser) KeyedElement() (localctx IKeyedElementContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(857)
+	p.SetState(919)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 94, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(854)
+			p.SetState(916)
 			p.Key()
 		}
 		{
-			p.SetState(855)
+			p.SetState(917)
 			p.Match(GoParserCOLON)
 		}
 
 	}
 	{
-		p.SetState(859)
+		p.SetState(921)
 		p.Element()
 	}
 
@@ -15260,7 +16172,7 @@ func (p *GoParser) Key() (localctx IKeyContext) {
 	_ = this
 
 	localctx = NewKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 170, GoParserRULE_key)
+	p.EnterRule(localctx, 180, GoParserRULE_key)
 
 	defer func() {
 		p.ExitRule()
@@ -15278,21 +16190,21 @@ func (p *GoParser) Key() (localctx IKeyContext) {
 		}
 	}()
 
-	p.SetState(863)
+	p.SetState(925)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserNIL_LIT, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserEXCLAMATION, GoParserPLUS, GoParserMINUS, GoParserCARET, GoParserSTAR, GoParserAMPERSAND, GoParserRECEIVE, GoParserDECIMAL_LIT, GoParserBINARY_LIT, GoParserOCTAL_LIT, GoParserHEX_LIT, GoParserFLOAT_LIT, GoParserIMAGINARY_LIT, GoParserRUNE_LIT, GoParserRAW_STRING_LIT, GoParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(861)
+			p.SetState(923)
 			p.expression(0)
 		}
 
 	case GoParserL_CURLY:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(862)
+			p.SetState(924)
 			p.LiteralValue()
 		}
 
@@ -15398,7 +16310,7 @@ func (p *GoParser) Element() (localctx IElementContext) {
 	_ = this
 
 	localctx = NewElementContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 172, GoParserRULE_element)
+	p.EnterRule(localctx, 182, GoParserRULE_element)
 
 	defer func() {
 		p.ExitRule()
@@ -15416,21 +16328,21 @@ func (p *GoParser) Element() (localctx IElementContext) {
 		}
 	}()
 
-	p.SetState(867)
+	p.SetState(929)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case GoParserFUNC, GoParserINTERFACE, GoParserMAP, GoParserSTRUCT, GoParserCHAN, GoParserNIL_LIT, GoParserIDENTIFIER, GoParserL_PAREN, GoParserL_BRACKET, GoParserEXCLAMATION, GoParserPLUS, GoParserMINUS, GoParserCARET, GoParserSTAR, GoParserAMPERSAND, GoParserRECEIVE, GoParserDECIMAL_LIT, GoParserBINARY_LIT, GoParserOCTAL_LIT, GoParserHEX_LIT, GoParserFLOAT_LIT, GoParserIMAGINARY_LIT, GoParserRUNE_LIT, GoParserRAW_STRING_LIT, GoParserINTERPRETED_STRING_LIT:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(865)
+			p.SetState(927)
 			p.expression(0)
 		}
 
 	case GoParserL_CURLY:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(866)
+			p.SetState(928)
 			p.LiteralValue()
 		}
 
@@ -15598,7 +16510,7 @@ func (p *GoParser) StructType() (localctx IStructTypeContext) {
 	_ = this
 
 	localctx = NewStructTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 174, GoParserRULE_structType)
+	p.EnterRule(localctx, 184, GoParserRULE_structType)
 	var _la int
 
 	defer func() {
@@ -15619,33 +16531,33 @@ func (p *GoParser) StructType() (localctx IStructTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(869)
+		p.SetState(931)
 		p.Match(GoParserSTRUCT)
 	}
 	{
-		p.SetState(870)
+		p.SetState(932)
 		p.Match(GoParserL_CURLY)
 	}
-	p.SetState(876)
+	p.SetState(938)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == GoParserIDENTIFIER || _la == GoParserSTAR {
 		{
-			p.SetState(871)
+			p.SetState(933)
 			p.FieldDecl()
 		}
 		{
-			p.SetState(872)
+			p.SetState(934)
 			p.Eos()
 		}
 
-		p.SetState(878)
+		p.SetState(940)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(879)
+		p.SetState(941)
 		p.Match(GoParserR_CURLY)
 	}
 
@@ -15790,7 +16702,7 @@ func (p *GoParser) FieldDecl() (localctx IFieldDeclContext) {
 	_ = this
 
 	localctx = NewFieldDeclContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 176, GoParserRULE_fieldDecl)
+	p.EnterRule(localctx, 186, GoParserRULE_fieldDecl)
 
 	defer func() {
 		p.ExitRule()
@@ -15809,32 +16721,32 @@ func (p *GoParser) FieldDecl() (localctx IFieldDeclContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(885)
+	p.SetState(947)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 98, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 108, p.GetParserRuleContext()) {
 	case 1:
 		{
-			p.SetState(881)
+			p.SetState(943)
 			p.IdentifierList()
 		}
 		{
-			p.SetState(882)
+			p.SetState(944)
 			p.Type_()
 		}
 
 	case 2:
 		{
-			p.SetState(884)
+			p.SetState(946)
 			p.EmbeddedField()
 		}
 
 	}
-	p.SetState(888)
+	p.SetState(950)
 	p.GetErrorHandler().Sync(p)
 
-	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 99, p.GetParserRuleContext()) == 1 {
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 109, p.GetParserRuleContext()) == 1 {
 		{
-			p.SetState(887)
+			p.SetState(949)
 
 			var _x = p.String_()
 
@@ -15917,7 +16829,7 @@ func (p *GoParser) String_() (localctx IString_Context) {
 	_ = this
 
 	localctx = NewString_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 178, GoParserRULE_string_)
+	p.EnterRule(localctx, 188, GoParserRULE_string_)
 	var _la int
 
 	defer func() {
@@ -15938,7 +16850,7 @@ func (p *GoParser) String_() (localctx IString_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(890)
+		p.SetState(952)
 		_la = p.GetTokenStream().LA(1)
 
 		if !(_la == GoParserRAW_STRING_LIT || _la == GoParserINTERPRETED_STRING_LIT) {
@@ -16010,6 +16922,22 @@ func (s *EmbeddedFieldContext) STAR() antlr.TerminalNode {
 	return s.GetToken(GoParserSTAR, 0)
 }
 
+func (s *EmbeddedFieldContext) TypeArgs() ITypeArgsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypeArgsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypeArgsContext)
+}
+
 func (s *EmbeddedFieldContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -16035,7 +16963,7 @@ func (p *GoParser) EmbeddedField() (localctx IEmbeddedFieldContext) {
 	_ = this
 
 	localctx = NewEmbeddedFieldContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 180, GoParserRULE_embeddedField)
+	p.EnterRule(localctx, 190, GoParserRULE_embeddedField)
 	var _la int
 
 	defer func() {
@@ -16055,21 +16983,31 @@ func (p *GoParser) EmbeddedField() (localctx IEmbeddedFieldContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	p.SetState(893)
+	p.SetState(955)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == GoParserSTAR {
 		{
-			p.SetState(892)
+			p.SetState(954)
 			p.Match(GoParserSTAR)
 		}
 
 	}
 	{
-		p.SetState(895)
+		p.SetState(957)
 		p.TypeName()
 	}
+	p.SetState(959)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 111, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(958)
+			p.TypeArgs()
+		}
+
+	}
 
 	return localctx
 }
@@ -16173,7 +17111,7 @@ func (p *GoParser) FunctionLit() (localctx IFunctionLitContext) {
 	_ = this
 
 	localctx = NewFunctionLitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 182, GoParserRULE_functionLit)
+	p.EnterRule(localctx, 192, GoParserRULE_functionLit)
 
 	defer func() {
 		p.ExitRule()
@@ -16193,15 +17131,15 @@ func (p *GoParser) FunctionLit() (localctx IFunctionLitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(897)
+		p.SetState(961)
 		p.Match(GoParserFUNC)
 	}
 	{
-		p.SetState(898)
+		p.SetState(962)
 		p.Signature()
 	}
 	{
-		p.SetState(899)
+		p.SetState(963)
 		p.Block()
 	}
 
@@ -16295,7 +17233,7 @@ func (p *GoParser) Index() (localctx IIndexContext) {
 	_ = this
 
 	localctx = NewIndexContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 184, GoParserRULE_index)
+	p.EnterRule(localctx, 194, GoParserRULE_index)
 
 	defer func() {
 		p.ExitRule()
@@ -16315,15 +17253,15 @@ func (p *GoParser) Index() (localctx IIndexContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(901)
+		p.SetState(965)
 		p.Match(GoParserL_BRACKET)
 	}
 	{
-		p.SetState(902)
+		p.SetState(966)
 		p.expression(0)
 	}
 	{
-		p.SetState(903)
+		p.SetState(967)
 		p.Match(GoParserR_BRACKET)
 	}
 
@@ -16450,7 +17388,7 @@ func (p *GoParser) Slice_() (localctx ISlice_Context) {
 	_ = this
 
 	localctx = NewSlice_Context(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 186, GoParserRULE_slice_)
+	p.EnterRule(localctx, 196, GoParserRULE_slice_)
 	var _la int
 
 	defer func() {
@@ -16471,72 +17409,72 @@ func (p *GoParser) Slice_() (localctx ISlice_Context) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(905)
+		p.SetState(969)
 		p.Match(GoParserL_BRACKET)
 	}
-	p.SetState(921)
+	p.SetState(985)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 115, p.GetParserRuleContext()) {
 	case 1:
-		p.SetState(907)
+		p.SetState(971)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(906)
+				p.SetState(970)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(909)
+			p.SetState(973)
 			p.Match(GoParserCOLON)
 		}
-		p.SetState(911)
+		p.SetState(975)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(910)
+				p.SetState(974)
 				p.expression(0)
 			}
 
 		}
 
 	case 2:
-		p.SetState(914)
+		p.SetState(978)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
 			{
-				p.SetState(913)
+				p.SetState(977)
 				p.expression(0)
 			}
 
 		}
 		{
-			p.SetState(916)
+			p.SetState(980)
 			p.Match(GoParserCOLON)
 		}
 		{
-			p.SetState(917)
+			p.SetState(981)
 			p.expression(0)
 		}
 		{
-			p.SetState(918)
+			p.SetState(982)
 			p.Match(GoParserCOLON)
 		}
 		{
-			p.SetState(919)
+			p.SetState(983)
 			p.expression(0)
 		}
 
 	}
 	{
-		p.SetState(923)
+		p.SetState(987)
 		p.Match(GoParserR_BRACKET)
 	}
 
@@ -16634,7 +17572,7 @@ func (p *GoParser) TypeAssertion() (localctx ITypeAssertionContext) {
 	_ = this
 
 	localctx = NewTypeAssertionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 188, GoParserRULE_typeAssertion)
+	p.EnterRule(localctx, 198, GoParserRULE_typeAssertion)
 
 	defer func() {
 		p.ExitRule()
@@ -16654,19 +17592,19 @@ func (p *GoParser) TypeAssertion() (localctx ITypeAssertionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(925)
+		p.SetState(989)
 		p.Match(GoParserDOT)
 	}
 	{
-		p.SetState(926)
+		p.SetState(990)
 		p.Match(GoParserL_PAREN)
 	}
 	{
-		p.SetState(927)
+		p.SetState(991)
 		p.Type_()
 	}
 	{
-		p.SetState(928)
+		p.SetState(992)
 		p.Match(GoParserR_PAREN)
 	}
 
@@ -16788,7 +17726,7 @@ func (p *GoParser) Arguments() (localctx IArgumentsContext) {
 	_ = this
 
 	localctx = NewArgumentsContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 190, GoParserRULE_arguments)
+	p.EnterRule(localctx, 200, GoParserRULE_arguments)
 	var _la int
 
 	defer func() {
@@ -16809,62 +17747,62 @@ func (p *GoParser) Arguments() (localctx IArgumentsContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(930)
+		p.SetState(994)
 		p.Match(GoParserL_PAREN)
 	}
-	p.SetState(945)
+	p.SetState(1009)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if (((_la-3)&-(0x1f+1)) == 0 && ((1<<uint((_la-3)))&((1<<(GoParserFUNC-3))|(1<<(GoParserINTERFACE-3))|(1<<(GoParserMAP-3))|(1<<(GoParserSTRUCT-3))|(1<<(GoParserCHAN-3))|(1<<(GoParserNIL_LIT-3))|(1<<(GoParserIDENTIFIER-3))|(1<<(GoParserL_PAREN-3))|(1<<(GoParserL_BRACKET-3)))) != 0) || (((_la-57)&-(0x1f+1)) == 0 && ((1<<uint((_la-57)))&((1<<(GoParserEXCLAMATION-57))|(1<<(GoParserPLUS-57))|(1<<(GoParserMINUS-57))|(1<<(GoParserCARET-57))|(1<<(GoParserSTAR-57))|(1<<(GoParserAMPERSAND-57))|(1<<(GoParserRECEIVE-57))|(1<<(GoParserDECIMAL_LIT-57))|(1<<(GoParserBINARY_LIT-57))|(1<<(GoParserOCTAL_LIT-57))|(1<<(GoParserHEX_LIT-57))|(1<<(GoParserFLOAT_LIT-57))|(1<<(GoParserIMAGINARY_LIT-57))|(1<<(GoParserRUNE_LIT-57))|(1<<(GoParserRAW_STRING_LIT-57))|(1<<(GoParserINTERPRETED_STRING_LIT-57)))) != 0) {
-		p.SetState(937)
+		p.SetState(1001)
 		p.GetErrorHandler().Sync(p)
-		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 106, p.GetParserRuleContext()) {
+		switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext()) {
 		case 1:
 			{
-				p.SetState(931)
+				p.SetState(995)
 				p.ExpressionList()
 			}
 
 		case 2:
 			{
-				p.SetState(932)
+				p.SetState(996)
 				p.NonNamedType()
 			}
-			p.SetState(935)
+			p.SetState(999)
 			p.GetErrorHandler().Sync(p)
 
-			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 105, p.GetParserRuleContext()) == 1 {
+			if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) == 1 {
 				{
-					p.SetState(933)
+					p.SetState(997)
 					p.Match(GoParserCOMMA)
 				}
 				{
-					p.SetState(934)
+					p.SetState(998)
 					p.ExpressionList()
 				}
 
 			}
 
 		}
-		p.SetState(940)
+		p.SetState(1004)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == GoParserELLIPSIS {
 			{
-				p.SetState(939)
+				p.SetState(1003)
 				p.Match(GoParserELLIPSIS)
 			}
 
 		}
-		p.SetState(943)
+		p.SetState(1007)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		if _la == GoParserCOMMA {
 			{
-				p.SetState(942)
+				p.SetState(1006)
 				p.Match(GoParserCOMMA)
 			}
 
@@ -16872,7 +17810,7 @@ func (p *GoParser) Arguments() (localctx IArgumentsContext) {
 
 	}
 	{
-		p.SetState(947)
+		p.SetState(1011)
 		p.Match(GoParserR_PAREN)
 	}
 
@@ -16966,7 +17904,7 @@ func (p *GoParser) MethodExpr() (localctx IMethodExprContext) {
 	_ = this
 
 	localctx = NewMethodExprContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 192, GoParserRULE_methodExpr)
+	p.EnterRule(localctx, 202, GoParserRULE_methodExpr)
 
 	defer func() {
 		p.ExitRule()
@@ -16986,15 +17924,15 @@ func (p *GoParser) MethodExpr() (localctx IMethodExprContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(949)
+		p.SetState(1013)
 		p.NonNamedType()
 	}
 	{
-		p.SetState(950)
+		p.SetState(1014)
 		p.Match(GoParserDOT)
 	}
 	{
-		p.SetState(951)
+		p.SetState(1015)
 		p.Match(GoParserIDENTIFIER)
 	}
 
@@ -17080,7 +18018,7 @@ func (p *GoParser) ReceiverType() (localctx IReceiverTypeContext) {
 	_ = this
 
 	localctx = NewReceiverTypeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 194, GoParserRULE_receiverType)
+	p.EnterRule(localctx, 204, GoParserRULE_receiverType)
 
 	defer func() {
 		p.ExitRule()
@@ -17100,7 +18038,7 @@ func (p *GoParser) ReceiverType() (localctx IReceiverTypeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(953)
+		p.SetState(1017)
 		p.Type_()
 	}
 
@@ -17182,7 +18120,7 @@ func (p *GoParser) Eos() (localctx IEosContext) {
 	_ = this
 
 	localctx = NewEosContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 196, GoParserRULE_eos)
+	p.EnterRule(localctx, 206, GoParserRULE_eos)
 
 	defer func() {
 		p.ExitRule()
@@ -17200,33 +18138,33 @@ func (p *GoParser) Eos() (localctx IEosContext) {
 		}
 	}()
 
-	p.SetState(959)
+	p.SetState(1023)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 110, p.GetParserRuleContext()) {
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 121, p.GetParserRuleContext()) {
 	case 1:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(955)
+			p.SetState(1019)
 			p.Match(GoParserSEMI)
 		}
 
 	case 2:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(956)
+			p.SetState(1020)
 			p.Match(GoParserEOF)
 		}
 
 	case 3:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(957)
+			p.SetState(1021)
 			p.Match(GoParserEOS)
 		}
 
 	case 4:
 		p.EnterOuterAlt(localctx, 4)
-		p.SetState(958)
+		p.SetState(1022)
 
 		if !(p.closingBracket()) {
 			panic(antlr.NewFailedPredicateException(p, "p.closingBracket()", ""))
@@ -17239,28 +18177,28 @@ func (p *GoParser) Eos() (localctx IEosContext) {
 
 func (p *GoParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
 	switch ruleIndex {
-	case 18:
+	case 22:
 		var t *StatementListContext = nil
 		if localctx != nil {
 			t = localctx.(*StatementListContext)
 		}
 		return p.StatementList_Sempred(t, predIndex)
 
-	case 70:
+	case 75:
 		var t *ExpressionContext = nil
 		if localctx != nil {
 			t = localctx.(*ExpressionContext)
 		}
 		return p.Expression_Sempred(t, predIndex)
 
-	case 71:
+	case 76:
 		var t *PrimaryExprContext = nil
 		if localctx != nil {
 			t = localctx.(*PrimaryExprContext)
 		}
 		return p.PrimaryExpr_Sempred(t, predIndex)
 
-	case 98:
+	case 103:
 		var t *EosContext = nil
 		if localctx != nil {
 			t = localctx.(*EosContext)
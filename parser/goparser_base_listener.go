@@ -93,6 +93,30 @@ func (s *BaseGoParserListener) EnterTypeSpec(ctx *TypeSpecContext) {}
 // ExitTypeSpec is called when production typeSpec is exited.
 func (s *BaseGoParserListener) ExitTypeSpec(ctx *TypeSpecContext) {}
 
+// EnterTypeParameters is called when production typeParameters is entered.
+func (s *BaseGoParserListener) EnterTypeParameters(ctx *TypeParametersContext) {}
+
+// ExitTypeParameters is called when production typeParameters is exited.
+func (s *BaseGoParserListener) ExitTypeParameters(ctx *TypeParametersContext) {}
+
+// EnterTypeParameterDecl is called when production typeParameterDecl is entered.
+func (s *BaseGoParserListener) EnterTypeParameterDecl(ctx *TypeParameterDeclContext) {}
+
+// ExitTypeParameterDecl is called when production typeParameterDecl is exited.
+func (s *BaseGoParserListener) ExitTypeParameterDecl(ctx *TypeParameterDeclContext) {}
+
+// EnterTypeElement is called when production typeElement is entered.
+func (s *BaseGoParserListener) EnterTypeElement(ctx *TypeElementContext) {}
+
+// ExitTypeElement is called when production typeElement is exited.
+func (s *BaseGoParserListener) ExitTypeElement(ctx *TypeElementContext) {}
+
+// EnterTypeTerm is called when production typeTerm is entered.
+func (s *BaseGoParserListener) EnterTypeTerm(ctx *TypeTermContext) {}
+
+// ExitTypeTerm is called when production typeTerm is exited.
+func (s *BaseGoParserListener) ExitTypeTerm(ctx *TypeTermContext) {}
+
 // EnterFunctionDecl is called when production functionDecl is entered.
 func (s *BaseGoParserListener) EnterFunctionDecl(ctx *FunctionDeclContext) {}
 
@@ -345,6 +369,12 @@ func (s *BaseGoParserListener) EnterType_(ctx *Type_Context) {}
 // ExitType_ is called when production type_ is exited.
 func (s *BaseGoParserListener) ExitType_(ctx *Type_Context) {}
 
+// EnterTypeArgs is called when production typeArgs is entered.
+func (s *BaseGoParserListener) EnterTypeArgs(ctx *TypeArgsContext) {}
+
+// ExitTypeArgs is called when production typeArgs is exited.
+func (s *BaseGoParserListener) ExitTypeArgs(ctx *TypeArgsContext) {}
+
 // EnterTypeName is called when production typeName is entered.
 func (s *BaseGoParserListener) EnterTypeName(ctx *TypeNameContext) {}
 
@@ -44,6 +44,18 @@ type GoParserListener interface {
 	// EnterTypeSpec is called when entering the typeSpec production.
 	EnterTypeSpec(c *TypeSpecContext)
 
+	// EnterTypeParameters is called when entering the typeParameters production.
+	EnterTypeParameters(c *TypeParametersContext)
+
+	// EnterTypeParameterDecl is called when entering the typeParameterDecl production.
+	EnterTypeParameterDecl(c *TypeParameterDeclContext)
+
+	// EnterTypeElement is called when entering the typeElement production.
+	EnterTypeElement(c *TypeElementContext)
+
+	// EnterTypeTerm is called when entering the typeTerm production.
+	EnterTypeTerm(c *TypeTermContext)
+
 	// EnterFunctionDecl is called when entering the functionDecl production.
 	EnterFunctionDecl(c *FunctionDeclContext)
 
@@ -170,6 +182,9 @@ type GoParserListener interface {
 	// EnterType_ is called when entering the type_ production.
 	EnterType_(c *Type_Context)
 
+	// EnterTypeArgs is called when entering the typeArgs production.
+	EnterTypeArgs(c *TypeArgsContext)
+
 	// EnterTypeName is called when entering the typeName production.
 	EnterTypeName(c *TypeNameContext)
 
@@ -347,6 +362,18 @@ type GoParserListener interface {
 	// ExitTypeSpec is called when exiting the typeSpec production.
 	ExitTypeSpec(c *TypeSpecContext)
 
+	// ExitTypeParameters is called when exiting the typeParameters production.
+	ExitTypeParameters(c *TypeParametersContext)
+
+	// ExitTypeParameterDecl is called when exiting the typeParameterDecl production.
+	ExitTypeParameterDecl(c *TypeParameterDeclContext)
+
+	// ExitTypeElement is called when exiting the typeElement production.
+	ExitTypeElement(c *TypeElementContext)
+
+	// ExitTypeTerm is called when exiting the typeTerm production.
+	ExitTypeTerm(c *TypeTermContext)
+
 	// ExitFunctionDecl is called when exiting the functionDecl production.
 	ExitFunctionDecl(c *FunctionDeclContext)
 
@@ -473,6 +500,9 @@ type GoParserListener interface {
 	// ExitType_ is called when exiting the type_ production.
 	ExitType_(c *Type_Context)
 
+	// ExitTypeArgs is called when exiting the typeArgs production.
+	ExitTypeArgs(c *TypeArgsContext)
+
 	// ExitTypeName is called when exiting the typeName production.
 	ExitTypeName(c *TypeNameContext)
 
@@ -0,0 +1,402 @@
+package parser
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// usedImports returns, for every file, the imports referenced by the signatures of the
+// selected methods and of the common interfaces, and by the constraints of their type
+// parameters. Qualifiers are resolved against the imports of the file the signature was
+// taken from.
+func (gen *InterfaceGenerator) usedImports(typeEntries map[string]*typeEntry, methods []*boundMethod, commons []*commonSet) [][]*ImportStmt {
+	used := make([]map[string]int, len(gen.Files)) // qualifier -> line of its first use
+	hasMethods := make([]bool, len(gen.Files))
+	for i := range gen.Files {
+		used[i] = map[string]int{}
+	}
+	use := func(file int, src string, line int) {
+		for _, q := range qualifiers(src) {
+			if _, ok := used[file][q.name]; !ok {
+				used[file][q.name] = line
+			}
+		}
+	}
+	for _, m := range methods {
+		if !gen.selected(m.recvType, typeEntries) || m.decl.Guard != "" {
+			continue // guarded methods are comments
+		}
+		hasMethods[m.file] = true
+		use(m.file, m.decl.Signature, m.decl.Line)
+	}
+	for _, set := range commons {
+		for _, m := range set.methods {
+			hasMethods[m.file] = true
+			use(m.file, m.decl.Signature, m.decl.Line)
+		}
+	}
+	for name, t := range typeEntries {
+		if !gen.selected(name, typeEntries) {
+			continue
+		}
+		for _, p := range t.decl.TypeParams {
+			use(t.file, p.Constraint, 0)
+		}
+	}
+
+	imports := make([][]*ImportStmt, len(gen.Files))
+	for i, f := range gen.Files {
+		names := map[string]struct{}{}
+		for _, imp := range f.Imports {
+			if imp.Alias == "." {
+				// the identifiers of a dot import cannot be told apart from local ones
+				if hasMethods[i] {
+					imports[i] = append(imports[i], imp)
+				}
+				continue
+			}
+			names[importName(imp)] = struct{}{}
+			if _, ok := used[i][importName(imp)]; ok {
+				imports[i] = append(imports[i], imp)
+			}
+		}
+		imports[i] = append(imports[i], gen.unknownImports(f, used[i], names)...)
+	}
+	return imports
+}
+
+// unknownImports returns the imports of f that the qualifiers matching none of names
+// refer to. The package name of an unaliased import may not be the one guessed from its
+// path, e.g. jsoniter for github.com/json-iterator/go: a qualifier is matched with the
+// only such import having it as an element of its path, or with the only one left. The
+// returned imports have the name of their qualifier, the others are reported.
+func (gen *InterfaceGenerator) unknownImports(f *SourceFileInfo, used map[string]int, names map[string]struct{}) []*ImportStmt {
+	var unknown []string
+	for name := range used {
+		if _, ok := names[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	sort.Strings(unknown)
+	var candidates []*ImportStmt
+	for _, imp := range f.Imports {
+		if _, ok := used[importName(imp)]; !ok && imp.Alias == "" && imp.Name == "" {
+			candidates = append(candidates, imp)
+		}
+	}
+
+	var result []*ImportStmt
+	match := func(name string, imp *ImportStmt) {
+		named := *imp
+		named.Name = name
+		result = append(result, &named)
+		for i, c := range candidates {
+			if c == imp {
+				candidates = append(candidates[:i:i], candidates[i+1:]...)
+				break
+			}
+		}
+	}
+	var unmatched []string
+	for _, name := range unknown {
+		var found []*ImportStmt
+		for _, imp := range candidates {
+			p, _ := strconv.Unquote(imp.Path)
+			for _, elem := range strings.Split(p, "/") {
+				if strings.ReplaceAll(elem, "-", "") == name {
+					found = append(found, imp)
+					break
+				}
+			}
+		}
+		if len(found) == 1 {
+			match(name, found[0])
+		} else {
+			unmatched = append(unmatched, name)
+		}
+	}
+	if len(unmatched) == 1 && len(candidates) == 1 {
+		match(unmatched[0], candidates[0])
+		return result
+	}
+	for _, name := range unmatched {
+		line := used[name]
+		if line == 0 {
+			line = 1
+		}
+		gen.report(&Diagnostic{File: f.FileName, Line: line, Column: 1, Severity: SeverityWarning,
+			Message: fmt.Sprintf("package %s matches none of the imports, the output does not import it", name)})
+	}
+	return result
+}
+
+// resolveImports merges the imports of the files so that every path is imported once
+// and every name refers to a single path. A path imported under several names keeps
+// its default name if one of the files uses it, otherwise the smallest alias. Paths
+// competing for the same name are handled in path order, the first one keeps the name
+// and the others are numbered, e.g. j, j2. The returned maps rewrite the qualifiers of
+// each file to the names in the output.
+func resolveImports(fileImports [][]*ImportStmt) ([]*ImportStmt, []map[string]string) {
+	pathNames := map[string]map[string]struct{}{}
+	pkgNames := map[string]string{} // path -> package name, if known
+	var dotImports []*ImportStmt
+	for _, imports := range fileImports {
+		for _, imp := range imports {
+			if imp.Alias == "." {
+				dotImports = append(dotImports, imp)
+				continue
+			}
+			names, ok := pathNames[imp.Path]
+			if !ok {
+				names = map[string]struct{}{}
+				pathNames[imp.Path] = names
+			}
+			names[importName(imp)] = struct{}{}
+			if imp.Name != "" {
+				pkgNames[imp.Path] = imp.Name
+			}
+		}
+	}
+
+	paths := make([]string, 0, len(pathNames))
+	for p := range pathNames {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+
+	assigned := make(map[string]string, len(paths)) // path -> name
+	taken := map[string]struct{}{}
+	result := dotImports
+	for _, p := range paths {
+		base := preferredName(&ImportStmt{Path: p, Name: pkgNames[p]}, pathNames[p])
+		name := base
+		for n := 2; ; n++ {
+			if _, ok := taken[name]; !ok {
+				break
+			}
+			name = fmt.Sprintf("%s%d", base, n)
+		}
+		taken[name] = struct{}{}
+		assigned[p] = name
+
+		// a package name that differs from the one of its path is explicit
+		imp := &ImportStmt{Path: p}
+		if name != importName(imp) {
+			imp.Alias = name
+		}
+		result = append(result, imp)
+	}
+
+	renames := make([]map[string]string, len(fileImports))
+	for i, imports := range fileImports {
+		for _, imp := range imports {
+			if imp.Alias == "." {
+				continue
+			}
+			if local := importName(imp); local != assigned[imp.Path] {
+				if renames[i] == nil {
+					renames[i] = map[string]string{}
+				}
+				renames[i][local] = assigned[imp.Path]
+			}
+		}
+	}
+	return result, renames
+}
+
+// sourceImport returns the import of the analysed package, named by sourceName. imports
+// may already have it.
+func (gen *InterfaceGenerator) sourceImport(imports []*ImportStmt) (*ImportStmt, error) {
+	src := gen.Files[0]
+	if src.PkgPath == "" {
+		return nil, fmt.Errorf("the import path of package %s is unknown", src.PkgName)
+	}
+	imp := &ImportStmt{Path: strconv.Quote(src.PkgPath)}
+	for _, i := range imports {
+		if i.Path == imp.Path {
+			return i, nil
+		}
+	}
+	if name := gen.sourceName(imports); name != importName(imp) {
+		imp.Alias = name
+	}
+	return imp, nil
+}
+
+// sourceName returns the name of the analysed package in the output, its package name,
+// numbered if one of imports or of the mock imports takes it.
+func (gen *InterfaceGenerator) sourceName(imports []*ImportStmt) string {
+	pkgName := gen.Files[0].PkgName
+	if pkgPath := gen.Files[0].PkgPath; pkgPath != "" {
+		for _, imp := range imports {
+			if imp.Path == strconv.Quote(pkgPath) {
+				return importName(imp)
+			}
+		}
+	}
+	taken := map[string]struct{}{}
+	for _, imp := range append(imports, mockImports...) {
+		taken[importName(imp)] = struct{}{}
+	}
+	name := pkgName
+	for n := 2; ; n++ {
+		if _, ok := taken[name]; !ok {
+			return name
+		}
+		name = fmt.Sprintf("%s%d", pkgName, n)
+	}
+}
+
+func preferredName(imp *ImportStmt, names map[string]struct{}) string {
+	if _, ok := names[importName(imp)]; ok {
+		return importName(imp)
+	}
+	sorted := make([]string, 0, len(names))
+	for n := range names {
+		sorted = append(sorted, n)
+	}
+	sort.Strings(sorted)
+	return sorted[0]
+}
+
+// renameMethod returns m with the qualifiers of its signature renamed, m itself is not modified.
+func renameMethod(m *MethodDecl, renames map[string]string) *MethodDecl {
+	if len(renames) == 0 {
+		return m
+	}
+	renamed := *m
+	renamed.Signature = renameQualifiers(m.Signature, renames)
+	renamed.Sig = nil // parsed again from the renamed Signature
+	return &renamed
+}
+
+// renameTypeDecl returns t with the qualifiers of its constraints renamed, t itself is not modified.
+func renameTypeDecl(t *TypeDecl, renames map[string]string) *TypeDecl {
+	if len(renames) == 0 || len(t.TypeParams) == 0 {
+		return t
+	}
+	renamed := *t
+	renamed.TypeParams = nil
+	for _, p := range t.TypeParams {
+		renamed.TypeParams = append(renamed.TypeParams, &TypeParam{Name: p.Name, Constraint: renameQualifiers(p.Constraint, renames)})
+	}
+	return &renamed
+}
+
+func renameQualifiers(src string, renames map[string]string) string {
+	sb := strings.Builder{}
+	last := 0
+	for _, q := range qualifiers(src) {
+		to, ok := renames[q.name]
+		if !ok {
+			continue
+		}
+		sb.WriteString(src[last:q.offset])
+		sb.WriteString(to)
+		last = q.offset + len(q.name)
+	}
+	sb.WriteString(src[last:])
+	return sb.String()
+}
+
+type qualifier struct {
+	name   string
+	offset int
+}
+
+// qualifiers returns the package qualifiers of src, e.g. strings in strings.Builder.
+func qualifiers(src string) []qualifier {
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+	var s scanner.Scanner
+	s.Init(file, []byte(src), nil, 0)
+
+	var result []qualifier
+	var prev, prevPrev token.Token
+	var prevIdent qualifier
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			return result
+		}
+		// IDENT . IDENT is a qualified identifier
+		if tok == token.IDENT && prev == token.PERIOD && prevPrev == token.IDENT {
+			result = append(result, prevIdent)
+		}
+		if tok == token.IDENT {
+			prevIdent = qualifier{name: lit, offset: file.Offset(pos)}
+		}
+		prevPrev, prev = prev, tok
+	}
+}
+
+// importName returns the name an import is referred to by, the alias, the package name
+// if it is known, or the one guessed from the import path, e.g. yaml for gopkg.in/yaml.v3.
+func importName(imp *ImportStmt) string {
+	if imp.Alias != "" {
+		return imp.Alias
+	}
+	if imp.Name != "" {
+		return imp.Name
+	}
+	p, err := strconv.Unquote(imp.Path)
+	if err != nil {
+		p = strings.Trim(imp.Path, "`\"")
+	}
+	name := path.Base(p)
+	if isMajorVersion(name) && path.Dir(p) != "." {
+		name = path.Base(path.Dir(p)) // github.com/foo/bar/v2
+	}
+	if i := strings.Index(name, "."); i > 0 {
+		name = name[:i] // yaml.v3
+	}
+	name = strings.TrimPrefix(name, "go-")
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+func isMajorVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' {
+		return false
+	}
+	_, err := strconv.Atoi(s[1:])
+	return err == nil
+}
+
+func emitImports(sb *strings.Builder, imp []*ImportStmt) {
+	if len(imp) == 0 {
+		return
+	}
+
+	// make unique
+	importsMap := make(map[ImportStmt]struct{})
+	for _, i := range imp {
+		importsMap[*i] = struct{}{}
+	}
+
+	// sort
+	sortedImports := make([]ImportStmt, 0, len(importsMap))
+	for i := range importsMap {
+		sortedImports = append(sortedImports, i)
+	}
+
+	sort.Slice(sortedImports, func(i, j int) bool {
+		if sortedImports[i].Path == sortedImports[j].Path {
+			return sortedImports[i].Alias < sortedImports[j].Alias
+		}
+		return sortedImports[i].Path < sortedImports[j].Path
+	})
+
+	sb.WriteString("import (\n")
+	for _, i := range sortedImports {
+		sb.WriteString(fmt.Sprintf("%s %s\n", i.Alias, i.Path))
+	}
+	sb.WriteString(")\n")
+}
@@ -0,0 +1,141 @@
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveImports(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   [][]*ImportStmt
+		imports []*ImportStmt
+		renames []map[string]string
+	}{
+		{
+			name: "alias collision",
+			files: [][]*ImportStmt{
+				{{Alias: "j", Path: `"encoding/json"`}},
+				{{Alias: "j", Path: `"github.com/json-iterator/go"`}},
+			},
+			imports: []*ImportStmt{
+				{Alias: "j", Path: `"encoding/json"`},
+				{Alias: "j2", Path: `"github.com/json-iterator/go"`},
+			},
+			renames: []map[string]string{nil, {"j": "j2"}},
+		},
+		{
+			name: "default name against alias",
+			files: [][]*ImportStmt{
+				{{Alias: "json", Path: `"github.com/json-iterator/go"`}},
+				{{Path: `"encoding/json"`}},
+			},
+			imports: []*ImportStmt{
+				{Path: `"encoding/json"`},
+				{Alias: "json2", Path: `"github.com/json-iterator/go"`},
+			},
+			renames: []map[string]string{{"json": "json2"}, nil},
+		},
+		{
+			name: "path under several aliases keeps its default name",
+			files: [][]*ImportStmt{
+				{{Alias: "js", Path: `"encoding/json"`}},
+				{{Path: `"encoding/json"`}},
+				{{Alias: "ej", Path: `"encoding/json"`}},
+			},
+			imports: []*ImportStmt{{Path: `"encoding/json"`}},
+			renames: []map[string]string{{"js": "json"}, nil, {"ej": "json"}},
+		},
+		{
+			name: "path under several aliases keeps the smallest",
+			files: [][]*ImportStmt{
+				{{Alias: "b", Path: `"encoding/json"`}},
+				{{Alias: "a", Path: `"encoding/json"`}},
+			},
+			imports: []*ImportStmt{{Alias: "a", Path: `"encoding/json"`}},
+			renames: []map[string]string{{"b": "a"}, nil},
+		},
+		{
+			name: "dot imports are kept as they are",
+			files: [][]*ImportStmt{
+				{{Alias: ".", Path: `"strings"`}, {Path: `"io"`}},
+				{{Alias: ".", Path: `"strings"`}, {Alias: "strings", Path: `"example.com/strings"`}},
+			},
+			imports: []*ImportStmt{
+				{Alias: ".", Path: `"strings"`},
+				{Alias: ".", Path: `"strings"`},
+				{Path: `"example.com/strings"`},
+				{Path: `"io"`},
+			},
+			renames: []map[string]string{nil, nil},
+		},
+		{
+			name: "known package name",
+			files: [][]*ImportStmt{
+				{{Path: `"github.com/json-iterator/go"`, Name: "jsoniter"}},
+				{{Path: `"k8s.io/api/core/v1"`, Name: "v1"}},
+			},
+			imports: []*ImportStmt{
+				{Alias: "jsoniter", Path: `"github.com/json-iterator/go"`},
+				{Alias: "v1", Path: `"k8s.io/api/core/v1"`},
+			},
+			renames: []map[string]string{nil, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			imports, renames := resolveImports(tt.files)
+			if !reflect.DeepEqual(imports, tt.imports) {
+				t.Errorf("imports = %s, want %s", importsString(imports), importsString(tt.imports))
+			}
+			if !reflect.DeepEqual(renames, tt.renames) {
+				t.Errorf("renames = %v, want %v", renames, tt.renames)
+			}
+		})
+	}
+}
+
+func importsString(imports []*ImportStmt) []string {
+	var result []string
+	for _, imp := range imports {
+		result = append(result, imp.Alias+" "+imp.Path)
+	}
+	return result
+}
+
+func TestQualifiers(t *testing.T) {
+	tests := []struct {
+		src  string
+		want []qualifier
+	}{
+		{"()", nil},
+		{"(b strings.Builder) error", []qualifier{{"strings", 3}}},
+		{"(r io.Reader, m map[j.Key][]*j.Value) (x.T, error)", []qualifier{{"io", 3}, {"j", 20}, {"j", 29}, {"x", 39}}},
+		{"(f func(ctx context.Context) error)", []qualifier{{"context", 12}}},
+		{"(s struct{ r io.Reader })", []qualifier{{"io", 13}}},
+	}
+	for _, tt := range tests {
+		if got := qualifiers(tt.src); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("qualifiers(%q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestRenameQualifiers(t *testing.T) {
+	tests := []struct {
+		src     string
+		renames map[string]string
+		want    string
+	}{
+		{"(v j.Value) error", nil, "(v j.Value) error"},
+		{"(v j.Value) error", map[string]string{"j": "j2"}, "(v j2.Value) error"},
+		{"(a j.A, b js.B) (j.C, jj.D)", map[string]string{"j": "json", "js": "j"}, "(a json.A, b j.B) (json.C, jj.D)"},
+		{"(j int, v j.Value)", map[string]string{"j": "j2"}, "(j int, v j2.Value)"},
+		{"(v Value)", map[string]string{"j": "j2"}, "(v Value)"},
+	}
+	for _, tt := range tests {
+		if got := renameQualifiers(tt.src, tt.renames); got != tt.want {
+			t.Errorf("renameQualifiers(%q, %v) = %q, want %q", tt.src, tt.renames, got, tt.want)
+		}
+	}
+}
@@ -8,30 +8,88 @@ import (
 )
 
 const (
-	COMMENT = "// Code generated from gointerface\n"
+	COMMENT = "// Code generated by gointerface. DO NOT EDIT.\n"
+	// legacyComment is the header of the files generated by the previous versions.
+	legacyComment = "// Code generated from gointerface\n"
 )
 
 type SourceFileInfo struct {
-	PkgName string
-	Imports []*ImportStmt
-	Methods []*MethodDecl
+	FileName    string
+	PkgName     string
+	PkgPath     string // import path of the package, empty if unknown
+	Generated   bool   // the file was generated by gointerface
+	Imports     []*ImportStmt
+	Types       []*TypeDecl
+	Methods     []*MethodDecl
+	Decls       []string // top-level function, variable and constant names
+	Diagnostics []*Diagnostic
+}
+
+// HasErrors reports whether the file has error diagnostics.
+func (f *SourceFileInfo) HasErrors() bool {
+	for _, d := range f.Diagnostics {
+		if d.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
 }
 
 type ImportStmt struct {
 	Alias string
 	Path  string
+	Name  string // package name if it is known, e.g. from go/types, else it is guessed from Path
+}
+
+// TypeDecl is a type declaration, TypeParams is empty unless the type is generic.
+// Fields are only set for struct types, Methods for interface types and Embedded for both.
+type TypeDecl struct {
+	Identifier  string
+	TypeParams  []*TypeParam
+	Fields      []string // field names, embedded fields included
+	Embedded    []*EmbeddedField
+	IsInterface bool
+	// Methods are the method specs of an interface type. Type-aware backends resolve the
+	// embedded interfaces and list their methods too, instead of setting Embedded.
+	Methods    []*MethodDecl
+	Directives []*Directive // //gointerface:generate annotations of the doc comment
+	Name       string       // interface name of //gointerface:name=Name
+	Exclude    bool         // annotated with //gointerface:exclude
+}
+
+// EmbeddedField is an embedded field of a struct type, e.g. *sync.Mutex, or an interface
+// embedded in an interface type.
+type EmbeddedField struct {
+	Pkg       string // package qualifier, empty for types of the same package
+	TypeName  string
+	TypeArgs  []string
+	IsPointer bool
+	Methods   []*MethodDecl // method set of a type of another package, set by type-aware backends
+}
+
+// TypeParam is a type parameter with its constraint, e.g. T ~int | string.
+type TypeParam struct {
+	Name       string
+	Constraint string
 }
 
 type ReceiverDecl struct {
 	StructType string
 	IsPointer  bool
+	TypeArgs   []string // type parameter names of a generic receiver, e.g. [K, V]
 }
 
 type MethodDecl struct {
 	Recv       *ReceiverDecl
 	Identifier string
 	Signature  string
+	Sig        *Signature // structured Signature, nil if the backend doesn't build it
 	Comment    string
+	Line       int // line of the declaration, 0 if unknown
+	// Guard lists the build configurations the method is limited to, e.g. linux/amd64.
+	// Guarded methods are emitted as comments.
+	Guard string
+	Group string // interface of //gointerface:group=Name, empty for the interface of the type
 }
 
 type MethodListener struct {
@@ -57,6 +115,61 @@ func (s *MethodListener) EnterPackageClause(c *PackageClauseContext) {
 	// fmt.Println(c.GetStart().GetLine(), c.packageName) // get line no
 	s.fileInfo = &SourceFileInfo{PkgName: c.packageName.GetText()}
 
+	stream := c.parser.GetInputStream().(*antlr.CommonTokenStream)
+	for _, t := range stream.GetHiddenTokensToLeft(c.GetStart().GetTokenIndex(), antlr.TokenHiddenChannel) {
+		if isGeneratedComment(t.GetText()) {
+			s.fileInfo.Generated = true
+		}
+	}
+}
+
+// isGeneratedComment reports whether comment is the header of the files gointerface generates.
+func isGeneratedComment(comment string) bool {
+	comment = strings.TrimSpace(comment)
+	return comment == strings.TrimSpace(COMMENT) || comment == strings.TrimSpace(legacyComment)
+}
+
+// IsGenerated reports whether src is a file generated by gointerface, i.e. has the
+// header before the package clause.
+func IsGenerated(src []byte) bool {
+	for _, line := range strings.Split(string(src), "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "package ") {
+			return false
+		}
+		if isGeneratedComment(line) {
+			return true
+		}
+	}
+	return false
+}
+
+// EnterFunctionDecl records the name of the function.
+func (s *MethodListener) EnterFunctionDecl(ctx *FunctionDeclContext) {
+	s.fileInfo.Decls = append(s.fileInfo.Decls, ctx.IDENTIFIER().GetText())
+}
+
+// EnterDeclaration records the names of the top-level constants and variables.
+func (s *MethodListener) EnterDeclaration(ctx *DeclarationContext) {
+	if _, ok := ctx.GetParent().(*SourceFileContext); !ok {
+		return
+	}
+	var lists []IIdentifierListContext
+	if c, ok := ctx.ConstDecl().(*ConstDeclContext); ok {
+		for _, spec := range c.AllConstSpec() {
+			lists = append(lists, spec.(*ConstSpecContext).IdentifierList())
+		}
+	}
+	if v, ok := ctx.VarDecl().(*VarDeclContext); ok {
+		for _, spec := range v.AllVarSpec() {
+			lists = append(lists, spec.(*VarSpecContext).IdentifierList())
+		}
+	}
+	for _, list := range lists {
+		for _, ident := range list.(*IdentifierListContext).AllIDENTIFIER() {
+			s.fileInfo.Decls = append(s.fileInfo.Decls, ident.GetText())
+		}
+	}
 }
 
 // EnterImportSpec is called when entering the importSpec production.
@@ -78,6 +191,134 @@ func (s *MethodListener) EnterImportSpec(c *ImportSpecContext) {
 	s.fileInfo.Imports = append(s.fileInfo.Imports, imp)
 }
 
+// EnterTypeSpec records the top-level type declaration together with its type parameters.
+func (s *MethodListener) EnterTypeSpec(ctx *TypeSpecContext) {
+	if _, ok := ctx.GetParent().GetParent().GetParent().(*SourceFileContext); !ok {
+		return // local to a function, it has no methods
+	}
+	decl := &TypeDecl{Identifier: ctx.IDENTIFIER().GetText()}
+	if params, ok := ctx.TypeParameters().(*TypeParametersContext); ok {
+		for _, d := range params.AllTypeParameterDecl() {
+			p := d.(*TypeParameterDeclContext)
+			constraint := tokenText(p.TypeElement())
+			for _, ident := range p.IdentifierList().(*IdentifierListContext).AllIDENTIFIER() {
+				decl.TypeParams = append(decl.TypeParams, &TypeParam{Name: ident.GetText(), Constraint: constraint})
+			}
+		}
+	}
+	if tp, ok := ctx.Type_().(*Type_Context); ok {
+		switch lit := tp.TypeLit().(type) {
+		case *DeclStructContext:
+			collectFields(decl, lit.StructType().(*StructTypeContext))
+		case *IgnContext:
+			if it, ok := lit.InterfaceType().(*InterfaceTypeContext); ok {
+				s.collectInterface(decl, it)
+			}
+		}
+	}
+	s.collectDirectives(decl, ctx)
+	s.fileInfo.Types = append(s.fileInfo.Types, decl)
+}
+
+// collectDirectives records the annotations of the doc comment of a type spec, which is
+// the one of the declaration unless the specs are grouped.
+func (s *MethodListener) collectDirectives(decl *TypeDecl, ctx *TypeSpecContext) {
+	stream := ctx.parser.GetInputStream().(*antlr.CommonTokenStream)
+	start := ctx.GetStart()
+	if parent, ok := ctx.GetParent().(*TypeDeclContext); ok && parent.L_PAREN() == nil {
+		start = parent.GetStart()
+	}
+	for _, t := range docComment(stream.GetHiddenTokensToLeft(start.GetTokenIndex(), antlr.TokenHiddenChannel)) {
+		annotateType(s.fileInfo, decl, t.GetText(), t.GetLine(), t.GetColumn()+1)
+	}
+}
+
+// docComment returns the comments of tokens that end on the line before the declaration,
+// the ones separated from it by a blank line are not part of its doc comment.
+func docComment(tokens []antlr.Token) []antlr.Token {
+	first := len(tokens)
+	for i := len(tokens) - 1; i >= 0; i-- {
+		if tokens[i].GetTokenType() == GoLexerTERMINATOR && strings.Count(tokens[i].GetText(), "\n") > 1 {
+			break
+		}
+		first = i
+	}
+	return tokens[first:]
+}
+
+// collectFields records the field names and the embedded fields of a struct type.
+func collectFields(decl *TypeDecl, st *StructTypeContext) {
+	for _, f := range st.AllFieldDecl() {
+		field := f.(*FieldDeclContext)
+		if idents, ok := field.IdentifierList().(*IdentifierListContext); ok {
+			for _, ident := range idents.AllIDENTIFIER() {
+				decl.Fields = append(decl.Fields, ident.GetText())
+			}
+			continue
+		}
+		ef := field.EmbeddedField().(*EmbeddedFieldContext)
+		embedded := &EmbeddedField{IsPointer: ef.STAR() != nil}
+		typeName := ef.TypeName().(*TypeNameContext)
+		if q, ok := typeName.QualifiedIdent().(*QualifiedIdentContext); ok {
+			embedded.Pkg = q.IDENTIFIER(0).GetText()
+			embedded.TypeName = q.IDENTIFIER(1).GetText()
+		} else {
+			embedded.TypeName = typeName.IDENTIFIER().GetText()
+		}
+		if args, ok := ef.TypeArgs().(*TypeArgsContext); ok {
+			list := args.TypeList().(*TypeListContext)
+			for _, t := range list.AllType_() {
+				embedded.TypeArgs = append(embedded.TypeArgs, t.GetText())
+			}
+		}
+		decl.Fields = append(decl.Fields, embedded.TypeName)
+		decl.Embedded = append(decl.Embedded, embedded)
+	}
+}
+
+// collectInterface records the method specs and the embedded interfaces of an interface
+// type. Type terms such as ~int or int | string are not interfaces and are left out.
+func (s *MethodListener) collectInterface(decl *TypeDecl, it *InterfaceTypeContext) {
+	decl.IsInterface = true
+	stream := it.GetParser().GetInputStream().(*antlr.CommonTokenStream)
+	for _, spec := range it.AllMethodSpec() {
+		ms := spec.(*MethodSpecContext)
+		ident := ms.IDENTIFIER().GetText()
+		if !s.IncludePrivate && unicode.IsLower(rune(ident[0])) {
+			continue
+		}
+		stop := ms.Parameters().GetStop()
+		if ms.Result() != nil {
+			stop = ms.Result().GetStop()
+		}
+		decl.Methods = append(decl.Methods, &MethodDecl{
+			Recv:       &ReceiverDecl{StructType: decl.Identifier},
+			Identifier: ident,
+			Signature:  stream.GetTextFromTokens(ms.Parameters().GetStart(), stop),
+			Line:       ms.GetStart().GetLine(),
+		})
+	}
+	for _, e := range it.AllTypeElement() {
+		terms := e.(*TypeElementContext).AllTypeTerm()
+		if len(terms) != 1 || terms[0].(*TypeTermContext).UNDERLYING() != nil {
+			continue
+		}
+		tp := terms[0].(*TypeTermContext).Type_().(*Type_Context)
+		typeName, ok := tp.TypeName().(*TypeNameContext)
+		if !ok || tp.TypeArgs() != nil {
+			continue
+		}
+		embedded := &EmbeddedField{}
+		if q, ok := typeName.QualifiedIdent().(*QualifiedIdentContext); ok {
+			embedded.Pkg = q.IDENTIFIER(0).GetText()
+			embedded.TypeName = q.IDENTIFIER(1).GetText()
+		} else {
+			embedded.TypeName = typeName.IDENTIFIER().GetText()
+		}
+		decl.Embedded = append(decl.Embedded, embedded)
+	}
+}
+
 func (s *MethodListener) EnterParameterDecl(ctx *ParameterDeclContext) {
 	if !s.inReceiver {
 		return
@@ -86,11 +327,15 @@ func (s *MethodListener) EnterParameterDecl(ctx *ParameterDeclContext) {
 	tp := ctx.Type_().GetText()
 	recv := s.currentMethod.Recv
 	if len(tp) > 0 && tp[0] == '*' {
-		recv.StructType = tp[1:]
+		tp = tp[1:]
 		recv.IsPointer = true
-	} else {
-		recv.StructType = tp
 	}
+	// generic receiver, e.g. List[T]
+	if i := strings.IndexByte(tp, '['); i >= 0 && tp[len(tp)-1] == ']' {
+		recv.TypeArgs = strings.Split(tp[i+1:len(tp)-1], ",")
+		tp = tp[:i]
+	}
+	recv.StructType = tp
 }
 
 func (s *MethodListener) EnterMethodDecl(ctx *MethodDeclContext) {
@@ -115,19 +360,33 @@ func (s *MethodListener) EnterMethodDecl(ctx *MethodDeclContext) {
 		Recv:       &ReceiverDecl{},
 		Identifier: ident,
 		Signature:  formatSignature(ctx.Signature()),
-		Comment:    comment}
+		Sig:        signatureFromTree(ctx.Signature()),
+		Comment:    comment,
+		Line:       startToken.GetLine()}
 }
 
 func formatSignature(sign ISignatureContext) string {
-	stream := sign.GetParser().GetInputStream().(*antlr.CommonTokenStream)
-	return stream.GetTextFromTokens(sign.GetStart(), sign.GetStop())
+	return tokenText(sign)
+}
+
+type parserRuleContext interface {
+	antlr.ParserRuleContext
+	GetParser() antlr.Parser
+}
+
+// tokenText returns the source text of ctx, hidden tokens included.
+func tokenText(ctx parserRuleContext) string {
+	stream := ctx.GetParser().GetInputStream().(*antlr.CommonTokenStream)
+	return stream.GetTextFromTokens(ctx.GetStart(), ctx.GetStop())
 }
 
 func (s *MethodListener) ExitMethodDecl(ctx *MethodDeclContext) {
 	if !s.inMethod {
 		return
 	}
-	s.fileInfo.Methods = append(s.fileInfo.Methods, s.currentMethod)
+	if annotateMethod(s.fileInfo, s.currentMethod) {
+		s.fileInfo.Methods = append(s.fileInfo.Methods, s.currentMethod)
+	}
 	s.inMethod = false
 	s.currentMethod = nil
 }
@@ -142,25 +401,3 @@ func (s *MethodListener) EnterReceiver(ctx *ReceiverContext) {
 func (s *MethodListener) ExitReceiver(ctx *ReceiverContext) {
 	s.inReceiver = false
 }
-
-type ErrorListener struct {
-	// *antlr.DefaultErrorListener
-}
-
-// func (l *ErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-// 	panic(msg)
-// }
-
-func (l *ErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
-	panic(msg)
-}
-func (l *ErrorListener) ReportAmbiguity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, exact bool, ambigAlts *antlr.BitSet, configs antlr.ATNConfigSet) {
-}
-func (l *ErrorListener) ReportAttemptingFullContext(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex int, conflictingAlts *antlr.BitSet, configs antlr.ATNConfigSet) {
-}
-func (l *ErrorListener) ReportContextSensitivity(recognizer antlr.Parser, dfa *antlr.DFA, startIndex, stopIndex, prediction int, configs antlr.ATNConfigSet) {
-}
-
-func NewErrorListener() *ErrorListener {
-	return &ErrorListener{}
-}
@@ -0,0 +1,242 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	"go/format"
+	goparser "go/parser"
+	"strings"
+	"unicode"
+)
+
+// mockImports are the imports the mocks need besides the ones of the signatures.
+var mockImports = []*ImportStmt{{Path: `"sync"`}, {Path: `"testing"`}}
+
+// GenerateMock generates the mocks of the interfaces in a file of its own, pkgName
+// overrides the package name of the interfaces.
+func (gen *InterfaceGenerator) GenerateMock(pkgName string) (string, error) {
+	if len(gen.Files) == 0 {
+		return "", nil
+	}
+	if pkgName == "" {
+		var err error
+		if pkgName, err = gen.packageName(); err != nil {
+			return "", err
+		}
+	}
+	imports, ifaces, err := gen.interfaces(pkgName)
+	if err != nil {
+		return "", err
+	}
+
+	sb := strings.Builder{}
+	sb.WriteString(COMMENT)
+	sb.WriteString("package ")
+	sb.WriteString(pkgName)
+	sb.WriteString("\n")
+	if len(ifaces) != 0 {
+		imports = append(imports, mockImports...)
+	}
+	emitImports(&sb, imports)
+	for _, iface := range ifaces {
+		if err := emitMock(&sb, iface); err != nil {
+			return "", err
+		}
+	}
+
+	code, err := format.Source([]byte(sb.String()))
+	return string(code), err
+}
+
+// mockParam is a parameter of a mocked method.
+type mockParam struct {
+	Name     string // name in the mock method
+	Field    string // field of the call record
+	Type     string
+	Variadic bool
+}
+
+// mockMethod is a mocked method with its parsed parameters.
+type mockMethod struct {
+	*MethodDecl
+	Params  []*mockParam
+	Results string // result list, e.g. (int, error)
+}
+
+// emitMock emits the mock of iface: a struct with a function field per method, the
+// recorded calls guarded by a mutex and helpers to inspect and assert them, e.g.
+//
+//	type MockIFoo struct {
+//		GetFunc func(key string) error
+//		...
+//	}
+func emitMock(sb *strings.Builder, iface *interfaceDecl) error {
+	name := "Mock" + iface.Name
+	typeArgs := ""
+	if len(iface.TypeArgs) != 0 {
+		typeArgs = "[" + strings.Join(iface.TypeArgs, ", ") + "]"
+	}
+
+	all := iface.allMethods()
+	methods := make([]*mockMethod, 0, len(all))
+	members := map[string]string{}
+	for _, m := range all {
+		if m.Guard != "" {
+			continue // not in the interface
+		}
+		mm, err := parseMockMethod(m)
+		if err != nil {
+			return fmt.Errorf("mock of %s: %w", iface.Name, err)
+		}
+		// the helpers must not collide with the mocked methods
+		for _, member := range []string{m.Identifier, m.Identifier + "Func", m.Identifier + "Calls", m.Identifier + "CallCount", "Assert" + m.Identifier + "Called"} {
+			if other, ok := members[member]; ok {
+				return fmt.Errorf("mock of %s: %s of method %s collides with method %s", iface.Name, member, m.Identifier, other)
+			}
+			members[member] = m.Identifier
+		}
+		methods = append(methods, mm)
+	}
+
+	sb.WriteString(fmt.Sprintf("// %s is a mock implementation of %s.\n", name, iface.Name))
+	sb.WriteString(fmt.Sprintf("type %s%s struct {\n", name, iface.TypeParams))
+	for _, m := range methods {
+		sb.WriteString(fmt.Sprintf("%sFunc func%s\n", m.Identifier, m.Signature))
+	}
+	sb.WriteString("\nmu sync.Mutex\n")
+	for _, m := range methods {
+		sb.WriteString(fmt.Sprintf("calls%s []%s%sCall%s\n", m.Identifier, name, m.Identifier, typeArgs))
+	}
+	sb.WriteString("}\n\n")
+
+	recv := fmt.Sprintf("(m *%s%s)", name, typeArgs)
+	for _, m := range methods {
+		call := fmt.Sprintf("%s%sCall", name, m.Identifier)
+
+		sb.WriteString(fmt.Sprintf("// %s records the arguments of a call of %s.\n", call, m.Identifier))
+		sb.WriteString(fmt.Sprintf("type %s%s struct {\n", call, iface.TypeParams))
+		for _, p := range m.Params {
+			if p.Variadic {
+				sb.WriteString(fmt.Sprintf("%s []%s\n", p.Field, p.Type))
+			} else {
+				sb.WriteString(fmt.Sprintf("%s %s\n", p.Field, p.Type))
+			}
+		}
+		sb.WriteString("}\n\n")
+
+		// the mocked method
+		var params, fields, args []string
+		for _, p := range m.Params {
+			if p.Variadic {
+				params = append(params, fmt.Sprintf("%s ...%s", p.Name, p.Type))
+				args = append(args, p.Name+"...")
+			} else {
+				params = append(params, fmt.Sprintf("%s %s", p.Name, p.Type))
+				args = append(args, p.Name)
+			}
+			fields = append(fields, fmt.Sprintf("%s: %s", p.Field, p.Name))
+		}
+		sb.WriteString(fmt.Sprintf("// %s calls %sFunc and records the call.\n", m.Identifier, m.Identifier))
+		sb.WriteString(fmt.Sprintf("func %s %s(%s) %s {\n", recv, m.Identifier, strings.Join(params, ", "), m.Results))
+		sb.WriteString("m.mu.Lock()\n")
+		sb.WriteString(fmt.Sprintf("m.calls%s = append(m.calls%s, %s%s{%s})\n", m.Identifier, m.Identifier, call, typeArgs, strings.Join(fields, ", ")))
+		sb.WriteString("m.mu.Unlock()\n")
+		sb.WriteString(fmt.Sprintf("if m.%sFunc == nil {\n", m.Identifier))
+		sb.WriteString(fmt.Sprintf("panic(\"%s.%s: %sFunc is not set\")\n}\n", name, m.Identifier, m.Identifier))
+		if m.Results != "" {
+			sb.WriteString("return ")
+		}
+		sb.WriteString(fmt.Sprintf("m.%sFunc(%s)\n}\n\n", m.Identifier, strings.Join(args, ", ")))
+
+		// helpers
+		sb.WriteString(fmt.Sprintf("// %sCalls returns the calls of %s.\n", m.Identifier, m.Identifier))
+		sb.WriteString(fmt.Sprintf("func %s %sCalls() []%s%s {\n", recv, m.Identifier, call, typeArgs))
+		sb.WriteString("m.mu.Lock()\ndefer m.mu.Unlock()\n")
+		sb.WriteString(fmt.Sprintf("return append([]%s%s(nil), m.calls%s...)\n}\n\n", call, typeArgs, m.Identifier))
+
+		sb.WriteString(fmt.Sprintf("// %sCallCount returns the number of calls of %s.\n", m.Identifier, m.Identifier))
+		sb.WriteString(fmt.Sprintf("func %s %sCallCount() int {\n", recv, m.Identifier))
+		sb.WriteString("m.mu.Lock()\ndefer m.mu.Unlock()\n")
+		sb.WriteString(fmt.Sprintf("return len(m.calls%s)\n}\n\n", m.Identifier))
+
+		sb.WriteString(fmt.Sprintf("// Assert%sCalled fails the test unless %s was called n times.\n", m.Identifier, m.Identifier))
+		sb.WriteString(fmt.Sprintf("func %s Assert%sCalled(t testing.TB, n int) {\n", recv, m.Identifier))
+		sb.WriteString("t.Helper()\n")
+		sb.WriteString(fmt.Sprintf("if c := m.%sCallCount(); c != n {\n", m.Identifier))
+		sb.WriteString(fmt.Sprintf("t.Errorf(\"%s.%s called %%d times, want %%d\", c, n)\n}\n}\n\n", name, m.Identifier))
+	}
+	return nil
+}
+
+// parseMockMethod parses the signature of m. Unnamed parameters are named after their
+// position, e.g. arg0, or the next free number if a parameter already has the name, so
+// the mock can pass them on.
+func parseMockMethod(m *MethodDecl) (*mockMethod, error) {
+	expr, err := goparser.ParseExpr("func" + m.Signature)
+	if err != nil {
+		return nil, fmt.Errorf("method %s: %w", m.Identifier, err)
+	}
+	ft, ok := expr.(*ast.FuncType)
+	if !ok {
+		return nil, fmt.Errorf("method %s: not a signature: %s", m.Identifier, m.Signature)
+	}
+
+	mm := &mockMethod{MethodDecl: m}
+	if ft.Results != nil && len(ft.Results.List) != 0 {
+		mm.Results = strings.TrimPrefix(exprString(&ast.FuncType{Params: &ast.FieldList{}, Results: ft.Results}), "func() ")
+	}
+	// the generated names must not shadow the named parameters, nor the receiver m
+	taken := map[string]struct{}{"m": {}}
+	for _, field := range ft.Params.List {
+		for _, ident := range field.Names {
+			taken[ident.Name] = struct{}{}
+		}
+	}
+	fields := map[string]struct{}{}
+	i := 0
+	for _, field := range ft.Params.List {
+		typ := field.Type
+		variadic := false
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = ellipsis.Elt
+			variadic = true
+		}
+		names := field.Names
+		if len(names) == 0 {
+			names = []*ast.Ident{nil}
+		}
+		for _, ident := range names {
+			var name string
+			if ident != nil && ident.Name != "_" && ident.Name != "m" {
+				name = ident.Name
+			} else {
+				name = unusedName("arg", i, taken)
+				taken[name] = struct{}{}
+			}
+			field := exportedName(name)
+			if _, ok := fields[field]; ok {
+				field = unusedName(field, i, fields)
+			}
+			fields[field] = struct{}{}
+			mm.Params = append(mm.Params, &mockParam{Name: name, Field: field, Type: exprString(typ), Variadic: variadic})
+			i++
+		}
+	}
+	return mm, nil
+}
+
+// unusedName returns prefix followed by the first number from n that isn't in used.
+func unusedName(prefix string, n int, used map[string]struct{}) string {
+	for ; ; n++ {
+		name := fmt.Sprintf("%s%d", prefix, n)
+		if _, ok := used[name]; !ok {
+			return name
+		}
+	}
+}
+
+func exportedName(name string) string {
+	r := []rune(name)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,425 @@
+package parser
+
+import (
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+)
+
+// Module is a module found by a ModuleResolver.
+type Module struct {
+	Path string // module path, the replaced one if the module replaces another
+	Dir  string // directory of its go.mod
+}
+
+// ModuleResolver computes the import paths of directories from the nearest go.mod, and
+// finds the directories of import paths, like the go command. The main modules, the ones
+// of the go.work of the working directory or else its go.mod, decide the replace
+// directives: a directory replacing a module takes its path. The files read are cached.
+type ModuleResolver struct {
+	wd       string
+	loaded   bool
+	goMods   map[string]*modfile.File // by directory, nil if it has none
+	mains    []*Module
+	sums     []string          // go.sum files of the main modules
+	replaces []*replacement    // in order of precedence, the last one wins
+	replaced map[string]string // directory of a local replacement -> module path it replaces
+	names    map[string]string // package names by import path
+}
+
+// replacement is a replace directive of a go.mod or go.work in Dir.
+type replacement struct {
+	*modfile.Replace
+	Dir string
+}
+
+// NewModuleResolver returns a resolver of the main modules of wd.
+func NewModuleResolver(wd string) *ModuleResolver {
+	return &ModuleResolver{wd: wd, goMods: map[string]*modfile.File{}, replaced: map[string]string{}, names: map[string]string{}}
+}
+
+// ImportPath returns the import path of the package in dir. Without a go.mod, dir must be
+// under the src directory of a GOPATH entry.
+func (r *ModuleResolver) ImportPath(dir string) (string, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", err
+	}
+	// the modules std and cmd of GOROOT don't prefix the paths of their packages
+	if rel, ok := subdir(filepath.Join(build.Default.GOROOT, "src"), dir); ok {
+		return strings.TrimPrefix(rel, "vendor/"), nil
+	}
+	mod, err := r.Module(dir)
+	if err != nil {
+		return "", err
+	}
+	if mod == nil {
+		return gopathImportPath(dir)
+	}
+	rel, err := filepath.Rel(mod.Dir, dir)
+	if err != nil {
+		return "", err
+	}
+	rel = filepath.ToSlash(rel)
+	switch {
+	case rel == ".":
+		return mod.Path, nil
+	case rel == "vendor":
+		return "", fmt.Errorf("%s is the vendor directory of module %s", dir, mod.Path)
+	case strings.HasPrefix(rel, "vendor/"):
+		// vendored packages keep the import path of their module
+		return strings.TrimPrefix(rel, "vendor/"), nil
+	}
+	return path.Join(mod.Path, rel), nil
+}
+
+// Module returns the module of dir, the one of the nearest go.mod, or nil if there is none.
+func (r *ModuleResolver) Module(dir string) (*Module, error) {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.loadMainModules(); err != nil {
+		return nil, err
+	}
+	for d := dir; ; d = filepath.Dir(d) {
+		f, err := r.goMod(d)
+		if err != nil {
+			return nil, err
+		}
+		if f != nil {
+			if p, ok := r.replaced[d]; ok {
+				return &Module{Path: p, Dir: d}, nil
+			}
+			if f.Module == nil {
+				return nil, fmt.Errorf("%s has no module directive", filepath.Join(d, "go.mod"))
+			}
+			return &Module{Path: f.Module.Mod.Path, Dir: d}, nil
+		}
+		if filepath.Dir(d) == d {
+			return nil, nil
+		}
+	}
+}
+
+// goMod returns the parsed go.mod of dir, nil if it has none.
+func (r *ModuleResolver) goMod(dir string) (*modfile.File, error) {
+	if f, ok := r.goMods[dir]; ok {
+		return f, nil
+	}
+	name := filepath.Join(dir, "go.mod")
+	data, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		r.goMods[dir] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	f, err := modfile.Parse(name, data, nil)
+	if err != nil {
+		return nil, err
+	}
+	r.goMods[dir] = f
+	return f, nil
+}
+
+// loadMainModules reads the replace directives of the main modules: the ones used by the
+// go.work of the working directory, or the module of its nearest go.mod.
+func (r *ModuleResolver) loadMainModules() error {
+	if r.loaded {
+		return nil
+	}
+	r.loaded = true
+	wd, err := filepath.Abs(r.wd)
+	if err != nil {
+		return err
+	}
+
+	workFile, err := findGoWork(wd)
+	if err != nil {
+		return err
+	}
+	if workFile == "" {
+		for d := wd; ; d = filepath.Dir(d) {
+			f, err := r.goMod(d)
+			if err != nil {
+				return err
+			}
+			if f != nil {
+				r.addMainModule(d, f)
+				return nil
+			}
+			if filepath.Dir(d) == d {
+				return nil
+			}
+		}
+	}
+
+	data, err := ioutil.ReadFile(workFile)
+	if err != nil {
+		return err
+	}
+	work, err := modfile.ParseWork(workFile, data, nil)
+	if err != nil {
+		return err
+	}
+	workDir := filepath.Dir(workFile)
+	for _, use := range work.Use {
+		dir := localPath(workDir, use.Path)
+		f, err := r.goMod(dir)
+		if err != nil {
+			return err
+		}
+		if f != nil {
+			r.addMainModule(dir, f)
+		}
+	}
+	// the replace directives of go.work take precedence over the ones of the modules
+	r.addReplaces(workDir, work.Replace)
+	r.sums = append(r.sums, filepath.Join(workDir, "go.work.sum"))
+	return nil
+}
+
+// addMainModule records the main module of the go.mod f in dir.
+func (r *ModuleResolver) addMainModule(dir string, f *modfile.File) {
+	if f.Module != nil {
+		r.mains = append(r.mains, &Module{Path: f.Module.Mod.Path, Dir: dir})
+	}
+	r.addReplaces(dir, f.Replace)
+	r.sums = append(r.sums, filepath.Join(dir, "go.sum"))
+}
+
+// addReplaces records the replacements of a go.mod or go.work of dir.
+func (r *ModuleResolver) addReplaces(dir string, replaces []*modfile.Replace) {
+	for _, rep := range replaces {
+		r.replaces = append(r.replaces, &replacement{Replace: rep, Dir: dir})
+		if modfile.IsDirectoryPath(rep.New.Path) {
+			r.replaced[localPath(dir, rep.New.Path)] = rep.Old.Path
+		}
+	}
+}
+
+// PackageDir returns the directory of the package importPath the way the go command finds
+// it offline: in the main modules, in GOROOT for the standard library, in the vendor
+// directory of the main modules, else in the module cache at the version their go.mod
+// requires, or at the highest version of their go.sum. The replace directives of the main
+// modules apply.
+func (r *ModuleResolver) PackageDir(importPath string) (string, error) {
+	if err := module.CheckImportPath(importPath); err != nil {
+		return "", err
+	}
+	if err := r.loadMainModules(); err != nil {
+		return "", err
+	}
+	// the paths of the main modules need no dot, e.g. module myapp
+	for _, m := range r.mains {
+		if rel, ok := within(importPath, m.Path); ok {
+			return filepath.Join(m.Dir, filepath.FromSlash(rel)), nil
+		}
+	}
+	if isStandard(importPath) {
+		if dir := filepath.Join(build.Default.GOROOT, "src", filepath.FromSlash(importPath)); isDir(dir) {
+			return dir, nil
+		}
+	}
+	for _, m := range r.mains {
+		if dir := filepath.Join(m.Dir, "vendor", filepath.FromSlash(importPath)); isDir(dir) {
+			return dir, nil
+		}
+	}
+
+	modPath, version, err := r.requirement(importPath)
+	if err != nil {
+		if isStandard(importPath) {
+			return "", fmt.Errorf("package %s is neither in the standard library nor in a module", importPath)
+		}
+		return "", err
+	}
+	rel, _ := within(importPath, modPath)
+	for i := len(r.replaces) - 1; i >= 0; i-- {
+		rep := r.replaces[i]
+		if rep.Old.Path != modPath || (rep.Old.Version != "" && rep.Old.Version != version) {
+			continue
+		}
+		if modfile.IsDirectoryPath(rep.New.Path) {
+			return filepath.Join(localPath(rep.Dir, rep.New.Path), filepath.FromSlash(rel)), nil
+		}
+		modPath, version = rep.New.Path, rep.New.Version
+		break
+	}
+
+	escapedPath, err := module.EscapePath(modPath)
+	if err != nil {
+		return "", err
+	}
+	escapedVersion, err := module.EscapeVersion(version)
+	if err != nil {
+		return "", err
+	}
+	dir := filepath.Join(moduleCache(), filepath.FromSlash(escapedPath)+"@"+escapedVersion)
+	if !isDir(dir) {
+		return "", fmt.Errorf("module %s@%s is not in the module cache, run go mod download %s@%s", modPath, version, modPath, version)
+	}
+	dir = filepath.Join(dir, filepath.FromSlash(rel))
+	if !isDir(dir) {
+		return "", fmt.Errorf("module %s@%s has no package %s", modPath, version, importPath)
+	}
+	return dir, nil
+}
+
+// PackageName returns the name of the package importPath, read from the package clauses
+// of its files in the directory of PackageDir.
+func (r *ModuleResolver) PackageName(importPath string) (string, error) {
+	if name, ok := r.names[importPath]; ok {
+		return name, nil
+	}
+	dir, err := r.PackageDir(importPath)
+	if err != nil {
+		return "", err
+	}
+	pkg, err := build.ImportDir(dir, 0)
+	if pkg.Name == "" {
+		return "", err
+	}
+	r.names[importPath] = pkg.Name
+	return pkg.Name, nil
+}
+
+// requirement returns the module providing importPath and its version: the longest module
+// path required by the main modules, else the longest one of their go.sum at its highest
+// version.
+func (r *ModuleResolver) requirement(importPath string) (string, string, error) {
+	modPath, version := "", ""
+	for _, m := range r.mains {
+		for _, req := range r.goMods[m.Dir].Require {
+			if _, ok := within(importPath, req.Mod.Path); ok && len(req.Mod.Path) > len(modPath) {
+				modPath, version = req.Mod.Path, req.Mod.Version
+			}
+		}
+	}
+	if modPath != "" {
+		return modPath, version, nil
+	}
+
+	for _, sum := range r.sums {
+		data, err := ioutil.ReadFile(sum)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return "", "", err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Fields(line)
+			// the lines of the go.mod files only don't mean the module is downloaded
+			if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") {
+				continue
+			}
+			if _, ok := within(importPath, fields[0]); !ok {
+				continue
+			}
+			if len(fields[0]) > len(modPath) || (fields[0] == modPath && semver.Compare(fields[1], version) > 0) {
+				modPath, version = fields[0], fields[1]
+			}
+		}
+	}
+	if modPath == "" {
+		return "", "", fmt.Errorf("no module provides package %s, it is neither required by go.mod nor in go.sum", importPath)
+	}
+	return modPath, version, nil
+}
+
+// within reports whether the package importPath is in the module modPath, and returns its
+// path relative to the module.
+func within(importPath, modPath string) (string, bool) {
+	if importPath == modPath {
+		return "", true
+	}
+	if strings.HasPrefix(importPath, modPath+"/") {
+		return importPath[len(modPath)+1:], true
+	}
+	return "", false
+}
+
+// isStandard reports whether importPath may be a package of the standard library, the
+// first element of its path has no dot.
+func isStandard(importPath string) bool {
+	first := importPath
+	if i := strings.IndexByte(importPath, '/'); i >= 0 {
+		first = importPath[:i]
+	}
+	return !strings.Contains(first, ".")
+}
+
+// moduleCache returns the directory of the module cache, GOMODCACHE or pkg/mod in GOPATH.
+func moduleCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	return filepath.Join(filepath.SplitList(build.Default.GOPATH)[0], "pkg", "mod")
+}
+
+// subdir returns the slash-separated path of dir relative to root, if dir is below root.
+func subdir(root, dir string) (string, bool) {
+	rel, err := filepath.Rel(root, dir)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", false
+	}
+	return filepath.ToSlash(rel), true
+}
+
+func isDir(dir string) bool {
+	info, err := os.Stat(dir)
+	return err == nil && info.IsDir()
+}
+
+// findGoWork returns the go.work of wd: the one of GOWORK, or the nearest one. It is empty
+// if there is none or GOWORK is off.
+func findGoWork(wd string) (string, error) {
+	switch gowork := os.Getenv("GOWORK"); gowork {
+	case "off":
+		return "", nil
+	case "":
+	default:
+		return gowork, nil
+	}
+	for d := wd; ; d = filepath.Dir(d) {
+		name := filepath.Join(d, "go.work")
+		if _, err := os.Stat(name); err == nil {
+			return name, nil
+		} else if !os.IsNotExist(err) {
+			return "", err
+		}
+		if filepath.Dir(d) == d {
+			return "", nil
+		}
+	}
+}
+
+// localPath returns the directory p of a go.mod or go.work in dir.
+func localPath(dir, p string) string {
+	p = filepath.FromSlash(p)
+	if !filepath.IsAbs(p) {
+		p = filepath.Join(dir, p)
+	}
+	return filepath.Clean(p)
+}
+
+// gopathImportPath returns the import path of dir under the src directory of a GOPATH entry.
+func gopathImportPath(dir string) (string, error) {
+	for _, root := range filepath.SplitList(build.Default.GOPATH) {
+		if rel, ok := subdir(filepath.Join(root, "src"), dir); ok {
+			return rel, nil
+		}
+	}
+	return "", fmt.Errorf("no go.mod found for %s, and it is not in GOPATH", dir)
+}
@@ -0,0 +1,391 @@
+package parser
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeTree creates files, by slash-separated path relative to root.
+func writeTree(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// moduleEnv makes the module cache, the GOPATH and the go.work of the resolvers the ones
+// of root, gowork is relative to root.
+func moduleEnv(t *testing.T, root, gowork string) {
+	t.Setenv("GOMODCACHE", filepath.Join(root, "modcache"))
+	if gowork == "" {
+		t.Setenv("GOWORK", "off")
+	} else {
+		t.Setenv("GOWORK", filepath.Join(root, gowork))
+	}
+	gopath := build.Default.GOPATH
+	build.Default.GOPATH = filepath.Join(root, "gopath")
+	t.Cleanup(func() { build.Default.GOPATH = gopath })
+}
+
+func TestModuleResolverImportPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   map[string]string
+		gowork  string
+		wd      string
+		dir     string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "module root",
+			files: map[string]string{"m/go.mod": "module example.com/m\n"},
+			wd:    "m",
+			dir:   "m",
+			want:  "example.com/m",
+		},
+		{
+			name:  "package of a module",
+			files: map[string]string{"m/go.mod": "module example.com/m\n", "m/sub/pkg/p.go": "package pkg\n"},
+			wd:    "m",
+			dir:   "m/sub/pkg",
+			want:  "example.com/m/sub/pkg",
+		},
+		{
+			name: "nearest go.mod",
+			files: map[string]string{
+				"m/go.mod":        "module example.com/m\n",
+				"m/nested/go.mod": "module example.com/nested\n",
+			},
+			wd:   "m",
+			dir:  "m/nested/x",
+			want: "example.com/nested/x",
+		},
+		{
+			name: "local replacement",
+			files: map[string]string{
+				"m/go.mod":   "module example.com/m\n\nrequire example.com/lib v1.0.0\n\nreplace example.com/lib => ../lib\n",
+				"lib/go.mod": "module lib\n",
+			},
+			wd:   "m",
+			dir:  "lib/pkg",
+			want: "example.com/lib/pkg",
+		},
+		{
+			name: "replacement of another main module",
+			files: map[string]string{
+				"m/go.mod":   "module example.com/m\n",
+				"lib/go.mod": "module lib\n",
+			},
+			wd:   "lib",
+			dir:  "lib/pkg",
+			want: "lib/pkg",
+		},
+		{
+			name: "go.work replacement",
+			files: map[string]string{
+				"go.work":  "go 1.22\n\nuse ./a\n\nreplace example.com/c => ./c\n",
+				"a/go.mod": "module example.com/a\n",
+				"c/go.mod": "module c\n",
+			},
+			gowork: "go.work",
+			wd:     "a",
+			dir:    "c/x",
+			want:   "example.com/c/x",
+		},
+		{
+			name: "go.work is off",
+			files: map[string]string{
+				"go.work":  "go 1.22\n\nuse ./a\n\nreplace example.com/c => ./c\n",
+				"a/go.mod": "module example.com/a\n",
+				"c/go.mod": "module c\n",
+			},
+			wd:   "a",
+			dir:  "c/x",
+			want: "c/x",
+		},
+		{
+			name:  "vendored package",
+			files: map[string]string{"m/go.mod": "module example.com/m\n"},
+			wd:    "m",
+			dir:   "m/vendor/example.com/v/pkg",
+			want:  "example.com/v/pkg",
+		},
+		{
+			name:    "vendor directory",
+			files:   map[string]string{"m/go.mod": "module example.com/m\n"},
+			wd:      "m",
+			dir:     "m/vendor",
+			wantErr: true,
+		},
+		{
+			name:  "GOPATH",
+			files: map[string]string{"gopath/src/example.com/old/pkg/p.go": "package pkg\n"},
+			wd:    "gopath/src/example.com/old",
+			dir:   "gopath/src/example.com/old/pkg",
+			want:  "example.com/old/pkg",
+		},
+		{
+			name:    "neither module nor GOPATH",
+			files:   map[string]string{"x/p.go": "package x\n"},
+			wd:      "x",
+			dir:     "x",
+			wantErr: true,
+		},
+		{
+			name:    "go.mod without module directive",
+			files:   map[string]string{"m/go.mod": "go 1.22\n"},
+			wd:      "m",
+			dir:     "m",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, tt.files)
+			moduleEnv(t, root, tt.gowork)
+			r := NewModuleResolver(filepath.Join(root, tt.wd))
+			got, err := r.ImportPath(filepath.Join(root, filepath.FromSlash(tt.dir)))
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ImportPath(%s) = %s, want an error", tt.dir, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ImportPath(%s): %v", tt.dir, err)
+			}
+			if got != tt.want {
+				t.Errorf("ImportPath(%s) = %s, want %s", tt.dir, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestModuleResolverImportPathGOROOT(t *testing.T) {
+	r := NewModuleResolver(".")
+	for dir, want := range map[string]string{
+		"net/url":                     "net/url",
+		"vendor/golang.org/x/net/dns": "golang.org/x/net/dns",
+	} {
+		got, err := r.ImportPath(filepath.Join(build.Default.GOROOT, "src", filepath.FromSlash(dir)))
+		if err != nil {
+			t.Fatalf("ImportPath(%s): %v", dir, err)
+		}
+		if got != want {
+			t.Errorf("ImportPath(%s) = %s, want %s", dir, got, want)
+		}
+	}
+}
+
+func TestModuleResolverPackageDir(t *testing.T) {
+	const mainMod = "module example.com/m\n\ngo 1.22\n"
+	tests := []struct {
+		name       string
+		files      map[string]string
+		gowork     string
+		importPath string
+		want       string // relative to the root of the tree
+		wantErr    bool
+	}{
+		{
+			name:       "main module",
+			files:      map[string]string{"m/go.mod": mainMod},
+			importPath: "example.com/m/sub",
+			want:       "m/sub",
+		},
+		{
+			name: "vendor directory",
+			files: map[string]string{
+				"m/go.mod":                               mainMod + "\nrequire example.com/v v1.0.0\n",
+				"m/vendor/example.com/v/pkg/p.go":        "package pkg\n",
+				"modcache/example.com/v@v1.0.0/pkg/p.go": "package pkg\n",
+			},
+			importPath: "example.com/v/pkg",
+			want:       "m/vendor/example.com/v/pkg",
+		},
+		{
+			name: "required version",
+			files: map[string]string{
+				"m/go.mod": mainMod + "\nrequire example.com/dep v1.2.0\n",
+				"modcache/example.com/dep@v1.1.0/pkg/p.go": "package pkg\n",
+				"modcache/example.com/dep@v1.2.0/pkg/p.go": "package pkg\n",
+			},
+			importPath: "example.com/dep/pkg",
+			want:       "modcache/example.com/dep@v1.2.0/pkg",
+		},
+		{
+			name: "longest required module",
+			files: map[string]string{
+				"m/go.mod": mainMod + "\nrequire (\n\texample.com/dep v1.2.0\n\texample.com/dep/sub v0.1.0\n)\n",
+				"modcache/example.com/dep@v1.2.0/sub/pkg/p.go": "package pkg\n",
+				"modcache/example.com/dep/sub@v0.1.0/pkg/p.go": "package pkg\n",
+			},
+			importPath: "example.com/dep/sub/pkg",
+			want:       "modcache/example.com/dep/sub@v0.1.0/pkg",
+		},
+		{
+			name: "escaped path",
+			files: map[string]string{
+				"m/go.mod": mainMod + "\nrequire example.com/Upper v1.0.0\n",
+				"modcache/example.com/!upper@v1.0.0/p.go": "package upper\n",
+			},
+			importPath: "example.com/Upper",
+			want:       "modcache/example.com/!upper@v1.0.0",
+		},
+		{
+			name: "highest version of go.sum",
+			files: map[string]string{
+				"m/go.mod": mainMod,
+				"m/go.sum": "example.com/sum v1.2.0 h1:a=\n" +
+					"example.com/sum v1.10.0 h1:b=\n" +
+					"example.com/sum v1.20.0/go.mod h1:c=\n",
+				"modcache/example.com/sum@v1.2.0/p.go":  "package sum\n",
+				"modcache/example.com/sum@v1.10.0/p.go": "package sum\n",
+			},
+			importPath: "example.com/sum",
+			want:       "modcache/example.com/sum@v1.10.0",
+		},
+		{
+			name: "local replacement",
+			files: map[string]string{
+				"m/go.mod":   mainMod + "\nrequire example.com/dep v1.2.0\n\nreplace example.com/dep => ../dep\n",
+				"dep/go.mod": "module example.com/dep\n",
+			},
+			importPath: "example.com/dep/pkg",
+			want:       "dep/pkg",
+		},
+		{
+			name: "module replacement",
+			files: map[string]string{
+				"m/go.mod": mainMod + "\nrequire example.com/dep v1.2.0\n\nreplace example.com/dep => example.com/fork v1.3.0\n",
+				"modcache/example.com/fork@v1.3.0/pkg/p.go": "package pkg\n",
+			},
+			importPath: "example.com/dep/pkg",
+			want:       "modcache/example.com/fork@v1.3.0/pkg",
+		},
+		{
+			name: "replacement of another version",
+			files: map[string]string{
+				"m/go.mod": mainMod + "\nrequire example.com/dep v1.2.0\n\nreplace example.com/dep v1.0.0 => ../dep\n",
+				"modcache/example.com/dep@v1.2.0/pkg/p.go": "package pkg\n",
+			},
+			importPath: "example.com/dep/pkg",
+			want:       "modcache/example.com/dep@v1.2.0/pkg",
+		},
+		{
+			name: "go.work replacement wins",
+			files: map[string]string{
+				"go.work":    "go 1.22\n\nuse ./m\n\nreplace example.com/dep => ./work-dep\n",
+				"m/go.mod":   mainMod + "\nrequire example.com/dep v1.2.0\n\nreplace example.com/dep => ../dep\n",
+				"dep/go.mod": "module example.com/dep\n",
+			},
+			gowork:     "go.work",
+			importPath: "example.com/dep/pkg",
+			want:       "work-dep/pkg",
+		},
+		{
+			name: "other main module of go.work",
+			files: map[string]string{
+				"go.work":    "go 1.22\n\nuse (\n\t./m\n\t./lib\n)\n",
+				"m/go.mod":   mainMod,
+				"lib/go.mod": "module example.com/lib\n",
+			},
+			gowork:     "go.work",
+			importPath: "example.com/lib/pkg",
+			want:       "lib/pkg",
+		},
+		{
+			name:       "main module without a dot",
+			files:      map[string]string{"m/go.mod": "module myapp\n"},
+			importPath: "myapp/svc",
+			want:       "m/svc",
+		},
+		{
+			name: "replacement without a dot",
+			files: map[string]string{
+				"m/go.mod":     mainMod + "\nrequire mylib v0.0.0\n\nreplace mylib => ../mylib\n",
+				"mylib/go.mod": "module mylib\n",
+			},
+			importPath: "mylib/pkg",
+			want:       "mylib/pkg",
+		},
+		{
+			name:       "neither standard nor required",
+			files:      map[string]string{"m/go.mod": mainMod},
+			importPath: "myapp/svc",
+			wantErr:    true,
+		},
+		{
+			name:       "not in the module cache",
+			files:      map[string]string{"m/go.mod": mainMod + "\nrequire example.com/dep v1.2.0\n"},
+			importPath: "example.com/dep/pkg",
+			wantErr:    true,
+		},
+		{
+			name: "no such package in the module",
+			files: map[string]string{
+				"m/go.mod":                             mainMod + "\nrequire example.com/dep v1.2.0\n",
+				"modcache/example.com/dep@v1.2.0/p.go": "package dep\n",
+			},
+			importPath: "example.com/dep/missing",
+			wantErr:    true,
+		},
+		{
+			name:       "no module provides the package",
+			files:      map[string]string{"m/go.mod": mainMod},
+			importPath: "example.com/unknown",
+			wantErr:    true,
+		},
+		{
+			name:       "invalid import path",
+			files:      map[string]string{"m/go.mod": mainMod},
+			importPath: "example.com/a b",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := t.TempDir()
+			writeTree(t, root, tt.files)
+			moduleEnv(t, root, tt.gowork)
+			r := NewModuleResolver(filepath.Join(root, "m"))
+			got, err := r.PackageDir(tt.importPath)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("PackageDir(%s) = %s, want an error", tt.importPath, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("PackageDir(%s): %v", tt.importPath, err)
+			}
+			if want := filepath.Join(root, filepath.FromSlash(tt.want)); got != want {
+				t.Errorf("PackageDir(%s) = %s, want %s", tt.importPath, got, want)
+			}
+		})
+	}
+}
+
+func TestModuleResolverPackageDirGOROOT(t *testing.T) {
+	r := NewModuleResolver(t.TempDir())
+	got, err := r.PackageDir("net/url")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(build.Default.GOROOT, "src", "net", "url"); got != want {
+		t.Errorf("PackageDir(net/url) = %s, want %s", got, want)
+	}
+	if got, err := r.PackageDir("net/nosuchpackage"); err == nil {
+		t.Errorf("PackageDir(net/nosuchpackage) = %s, want an error", got)
+	}
+}
@@ -0,0 +1,136 @@
+package parser
+
+import "sort"
+
+// promotedMethods returns the methods promoted to typeName through its embedded fields.
+// A name declared at a shallower depth, as a method or as a field, shadows the deeper
+// ones and a name declared more than once at the same depth is ambiguous and left out.
+// A promoted method belongs to the value method set if any embedding on its path is a
+// pointer, otherwise it keeps the receiver of its declaration.
+//
+// Embedded types declared in the files are resolved here, interfaces included, the method
+// sets of other embedded types are only known if the backend provides EmbeddedField.Methods.
+// Generic embedded types are not resolved, their signatures would need the type
+// arguments substituted.
+func promotedMethods(typeName string, typeEntries map[string]*typeEntry, direct map[string][]*boundMethod) []*boundMethod {
+	root, ok := typeEntries[typeName]
+	if !ok || root.decl.IsInterface {
+		return nil
+	}
+	seen := map[string]struct{}{}
+	for _, m := range direct[typeName] {
+		seen[m.decl.Identifier] = struct{}{}
+	}
+	for _, f := range root.decl.Fields {
+		seen[f] = struct{}{}
+	}
+
+	type embedding struct {
+		entry      *typeEntry
+		viaPointer bool
+	}
+	visited := map[string]struct{}{typeName: {}}
+	level := []embedding{{entry: root}}
+	var result []*boundMethod
+	for len(level) != 0 {
+		candidates := map[string][]*boundMethod{}
+		fields := map[string]struct{}{}
+		var next []embedding
+		for _, e := range level {
+			for _, field := range e.entry.decl.Embedded {
+				viaPointer := e.viaPointer || field.IsPointer
+				local, isLocal := typeEntries[field.TypeName]
+				if field.Pkg != "" || !isLocal {
+					for _, m := range field.Methods {
+						candidates[m.Identifier] = append(candidates[m.Identifier], &boundMethod{
+							decl: m, file: e.entry.file, recvType: typeName, isPointer: m.Recv.IsPointer && !viaPointer})
+					}
+					continue
+				}
+				if len(field.TypeArgs) != 0 {
+					continue
+				}
+				if local.decl.IsInterface {
+					for _, m := range interfaceMethods(local, typeEntries, map[string]struct{}{}) {
+						candidates[m.decl.Identifier] = append(candidates[m.decl.Identifier], &boundMethod{
+							decl: m.decl, file: m.file, recvType: typeName})
+					}
+					continue
+				}
+				if _, ok := visited[field.TypeName]; ok {
+					continue
+				}
+				visited[field.TypeName] = struct{}{}
+				for _, m := range direct[field.TypeName] {
+					candidates[m.decl.Identifier] = append(candidates[m.decl.Identifier], &boundMethod{
+						decl: m.decl, file: m.file, recvType: typeName, isPointer: m.isPointer && !viaPointer})
+				}
+				for _, f := range local.decl.Fields {
+					fields[f] = struct{}{}
+				}
+				next = append(next, embedding{entry: local, viaPointer: viaPointer})
+			}
+		}
+
+		names := make([]string, 0, len(candidates))
+		for name := range candidates {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if _, ok := seen[name]; ok {
+				continue
+			}
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			if len(candidates[name]) == 1 {
+				result = append(result, candidates[name][0])
+			}
+		}
+		for name := range candidates {
+			seen[name] = struct{}{}
+		}
+		for name := range fields {
+			seen[name] = struct{}{}
+		}
+		level = next
+	}
+	return result
+}
+
+// interfaceMethods returns the methods of a local interface type, the ones of its embedded
+// interfaces included. A method embedded through several interfaces is listed once.
+func interfaceMethods(entry *typeEntry, typeEntries map[string]*typeEntry, visited map[string]struct{}) []*boundMethod {
+	visited[entry.decl.Identifier] = struct{}{}
+	seen := map[string]struct{}{}
+	var result []*boundMethod
+	add := func(m *boundMethod) {
+		if _, ok := seen[m.decl.Identifier]; !ok {
+			seen[m.decl.Identifier] = struct{}{}
+			result = append(result, m)
+		}
+	}
+	for _, m := range entry.decl.Methods {
+		add(&boundMethod{decl: m, file: entry.file})
+	}
+	for _, field := range entry.decl.Embedded {
+		if field.Pkg != "" {
+			for _, m := range field.Methods {
+				add(&boundMethod{decl: m, file: entry.file})
+			}
+			continue
+		}
+		embedded, ok := typeEntries[field.TypeName]
+		if !ok || !embedded.decl.IsInterface {
+			continue
+		}
+		if _, ok := visited[field.TypeName]; ok {
+			continue
+		}
+		for _, m := range interfaceMethods(embedded, typeEntries, visited) {
+			add(m)
+		}
+	}
+	return result
+}
@@ -0,0 +1,174 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"go/token"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// qualification qualifies the identifiers of the package of the types when the output
+// is in another package, e.g. Config becomes src.Config.
+type qualification struct {
+	gen    *InterfaceGenerator
+	name   string              // name of the source package in the output
+	locals map[string]struct{} // package-level declarations of the source package
+	used   bool                // an identifier was qualified
+}
+
+// newQualification returns the qualification of the source package, its name is set once
+// the imports are known. The declarations are the ones of the loaded files.
+func (gen *InterfaceGenerator) newQualification() *qualification {
+	q := &qualification{gen: gen, locals: map[string]struct{}{}}
+	for _, f := range gen.Files {
+		for _, t := range f.Types {
+			q.locals[t.Identifier] = struct{}{}
+		}
+		for _, d := range f.Decls {
+			q.locals[d] = struct{}{}
+		}
+	}
+	return q
+}
+
+// exportable returns the exported methods whose signatures only refer to exported
+// declarations of the source package, the others cannot be written in another package and
+// are reported.
+func (q *qualification) exportable(methods []*boundMethod) []*boundMethod {
+	result := methods[:0]
+	for _, m := range methods {
+		var msg string
+		if !token.IsExported(m.decl.Identifier) {
+			// an interface of another package with it cannot be implemented
+			msg = fmt.Sprintf("method %s.%s is unexported, it is left out of the interfaces of package %s",
+				m.recvType, m.decl.Identifier, q.gen.PkgName)
+		} else if _, unexported, err := q.rewrite(m.decl.Signature, true, m.decl.Recv.TypeArgs); err != nil {
+			msg = fmt.Sprintf("method %s.%s cannot be qualified: %v, it is left out", m.recvType, m.decl.Identifier, err)
+		} else if len(unexported) != 0 {
+			msg = fmt.Sprintf("method %s.%s refers to unexported %s of package %s, it is left out",
+				m.recvType, m.decl.Identifier, strings.Join(unexported, ", "), q.gen.Files[0].PkgName)
+		} else {
+			result = append(result, m)
+			continue
+		}
+		line := m.decl.Line
+		if line == 0 {
+			line = 1
+		}
+		q.gen.report(&Diagnostic{File: q.gen.Files[m.file].FileName, Line: line, Column: 1, Message: msg, Severity: SeverityWarning})
+	}
+	return result
+}
+
+// method returns m with the local identifiers of its signature qualified, m itself is not
+// modified. The signature must be exportable.
+func (q *qualification) method(m *MethodDecl) *MethodDecl {
+	sig, _, _ := q.rewrite(m.Signature, true, m.Recv.TypeArgs)
+	if sig == m.Signature {
+		return m
+	}
+	qualified := *m
+	qualified.Signature = sig
+	qualified.Sig = nil
+	return &qualified
+}
+
+// typeDecl returns t with the local identifiers of its constraints qualified, t itself is
+// not modified.
+func (q *qualification) typeDecl(t *TypeDecl) (*TypeDecl, error) {
+	if len(t.TypeParams) == 0 {
+		return t, nil
+	}
+	scope := make([]string, 0, len(t.TypeParams))
+	for _, p := range t.TypeParams {
+		scope = append(scope, p.Name)
+	}
+	qualified := *t
+	qualified.TypeParams = nil
+	for _, p := range t.TypeParams {
+		constraint, unexported, err := q.rewrite(p.Constraint, false, scope)
+		if err != nil {
+			return nil, fmt.Errorf("constraint of %s: %w", t.Identifier, err)
+		}
+		if len(unexported) != 0 {
+			return nil, fmt.Errorf("constraint of %s refers to unexported %s of package %s", t.Identifier, strings.Join(unexported, ", "), q.gen.Files[0].PkgName)
+		}
+		qualified.TypeParams = append(qualified.TypeParams, &TypeParam{Name: p.Name, Constraint: constraint})
+	}
+	return &qualified, nil
+}
+
+// rewrite qualifies the identifiers of src referring to local declarations. src is a
+// signature, e.g. (c Config) error, or a type expression. The identifiers of scope, the
+// type parameters, are left as they are. The unexported identifiers are returned sorted.
+func (q *qualification) rewrite(src string, isSignature bool, scope []string) (string, []string, error) {
+	prefix := ""
+	if isSignature {
+		prefix = "func"
+	}
+	fset := token.NewFileSet()
+	expr, err := goparser.ParseExprFrom(fset, "", prefix+src, 0)
+	if err != nil {
+		return "", nil, err
+	}
+	shadowed := make(map[string]struct{}, len(scope))
+	for _, name := range scope {
+		shadowed[name] = struct{}{}
+	}
+
+	var offsets []int
+	unexported := map[string]struct{}{}
+	q.references(expr, fset, len(prefix), shadowed, &offsets, unexported)
+
+	names := make([]string, 0, len(unexported))
+	for name := range unexported {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if len(offsets) == 0 || len(names) != 0 {
+		return src, names, nil
+	}
+	q.used = true
+	sort.Ints(offsets)
+	sb := strings.Builder{}
+	last := 0
+	for _, off := range offsets {
+		sb.WriteString(src[last:off])
+		sb.WriteString(q.name + ".")
+		last = off
+	}
+	sb.WriteString(src[last:])
+	return sb.String(), nil, nil
+}
+
+// references records the offsets of the local identifiers referenced by n, and the
+// unexported ones. The names of parameters, fields and methods are not references.
+func (q *qualification) references(n ast.Node, fset *token.FileSet, skip int, shadowed map[string]struct{}, offsets *[]int, unexported map[string]struct{}) {
+	ast.Inspect(n, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Field:
+			q.references(n.Type, fset, skip, shadowed, offsets, unexported)
+			return false
+		case *ast.SelectorExpr:
+			if _, ok := n.X.(*ast.Ident); ok {
+				return false // qualified identifier of another package
+			}
+		case *ast.Ident:
+			if _, ok := shadowed[n.Name]; ok {
+				return false
+			}
+			if _, ok := q.locals[n.Name]; !ok {
+				return false // predeclared or dot-imported
+			}
+			if !unicode.IsUpper(rune(n.Name[0])) {
+				unexported[n.Name] = struct{}{}
+				return false
+			}
+			*offsets = append(*offsets, fset.Position(n.Pos()).Offset-skip)
+		}
+		return true
+	})
+}
@@ -0,0 +1,189 @@
+package parser
+
+import (
+	"fmt"
+	"go/ast"
+	goparser "go/parser"
+	"strings"
+)
+
+// Signature is the structured form of a method signature. The ANTLR listener builds it from
+// the parameterDecl and result nodes of the parse tree, the ast backend from go/ast.
+type Signature struct {
+	Params   []*Param // one per name, (a, b int) has two parameters
+	Results  []*Param
+	Variadic bool // the last parameter is variadic, its Type is the element type
+}
+
+// Param is a parameter or a result, Name is empty if it is unnamed.
+type Param struct {
+	Name string
+	Type string // normalized source text of the type, e.g. map[string]int
+}
+
+// RenderOptions select how Render writes a signature.
+type RenderOptions uint
+
+const (
+	// StripNames leaves out the names of the parameters and results, e.g. (int, string).
+	StripNames RenderOptions = 1 << iota
+	// GroupParams merges the consecutive names of the same type, e.g. (a, b int).
+	GroupParams
+)
+
+// Render writes s canonically in the form of MethodDecl.Signature, e.g. (a int, b ...string) error.
+func (s *Signature) Render(opts RenderOptions) string {
+	sb := strings.Builder{}
+	sb.WriteString("(")
+	sb.WriteString(renderParams(s.Params, s.Variadic, opts))
+	sb.WriteString(")")
+	if len(s.Results) == 0 {
+		return sb.String()
+	}
+	results := renderParams(s.Results, false, opts)
+	if len(s.Results) == 1 && !named(s.Results, opts) {
+		sb.WriteString(" " + results)
+	} else {
+		sb.WriteString(" (" + results + ")")
+	}
+	return sb.String()
+}
+
+// named reports whether params are written with their names.
+func named(params []*Param, opts RenderOptions) bool {
+	return opts&StripNames == 0 && len(params) != 0 && params[0].Name != ""
+}
+
+func renderParams(params []*Param, variadic bool, opts RenderOptions) string {
+	parts := make([]string, 0, len(params))
+	var names []string
+	for i, p := range params {
+		typ := p.Type
+		last := i == len(params)-1
+		if variadic && last {
+			typ = "..." + typ
+		}
+		if !named(params, opts) {
+			parts = append(parts, typ)
+			continue
+		}
+		names = append(names, p.Name)
+		// a group ends where the type changes, the variadic parameter is a group of its own
+		if opts&GroupParams != 0 && !last && params[i+1].Type == p.Type && !(variadic && i+1 == len(params)-1) {
+			continue
+		}
+		parts = append(parts, strings.Join(names, ", ")+" "+typ)
+		names = nil
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ParseSignature parses a signature in the form of MethodDecl.Signature, e.g. (a, b int) error.
+func ParseSignature(src string) (*Signature, error) {
+	expr, err := goparser.ParseExpr("func" + src)
+	if err != nil {
+		return nil, err
+	}
+	ft, ok := expr.(*ast.FuncType)
+	if !ok {
+		return nil, fmt.Errorf("not a signature: %s", src)
+	}
+	return signatureFromAST(ft), nil
+}
+
+// signatureFromAST builds the signature of a function type.
+func signatureFromAST(ft *ast.FuncType) *Signature {
+	s := &Signature{}
+	for _, field := range ft.Params.List {
+		typ := field.Type
+		if ellipsis, ok := typ.(*ast.Ellipsis); ok {
+			typ = ellipsis.Elt
+			s.Variadic = true
+		}
+		s.Params = appendParams(s.Params, field.Names, exprString(typ))
+	}
+	if ft.Results != nil {
+		for _, field := range ft.Results.List {
+			s.Results = appendParams(s.Results, field.Names, exprString(field.Type))
+		}
+	}
+	return s
+}
+
+func appendParams(params []*Param, names []*ast.Ident, typ string) []*Param {
+	if len(names) == 0 {
+		return append(params, &Param{Type: typ})
+	}
+	for _, name := range names {
+		params = append(params, &Param{Name: name.Name, Type: typ})
+	}
+	return params
+}
+
+// signatureFromTree builds the signature of the parse tree of a method.
+func signatureFromTree(ctx ISignatureContext) *Signature {
+	s := &Signature{}
+	var params, result IParametersContext
+	switch sig := ctx.(type) {
+	case *ParamResultContext:
+		params = sig.Parameters()
+		r := sig.Result().(*ResultContext)
+		if r.Parameters() != nil {
+			result = r.Parameters()
+		} else {
+			s.Results = []*Param{{Type: normalizeType(tokenText(r.Type_()))}}
+		}
+	case *ParamSimpleContext:
+		params = sig.Parameters()
+	}
+	if params != nil {
+		s.Params, s.Variadic = treeParams(params.(*ParametersContext))
+	}
+	if result != nil {
+		s.Results, _ = treeParams(result.(*ParametersContext))
+	}
+	return s
+}
+
+// treeParams returns the parameters of a parameter list, and whether the last one is variadic.
+func treeParams(ctx *ParametersContext) ([]*Param, bool) {
+	var params []*Param
+	variadic := false
+	for _, d := range ctx.AllParameterDecl() {
+		decl := d.(*ParameterDeclContext)
+		typ := normalizeType(tokenText(decl.Type_()))
+		variadic = decl.ELLIPSIS() != nil
+		idents, ok := decl.IdentifierList().(*IdentifierListContext)
+		if !ok {
+			params = append(params, &Param{Type: typ})
+			continue
+		}
+		for _, ident := range idents.AllIDENTIFIER() {
+			params = append(params, &Param{Name: ident.GetText(), Type: typ})
+		}
+	}
+	return params, variadic
+}
+
+// normalizeType reprints the source text of a type the way go/printer does, so that both
+// backends agree on the spacing. Types that don't parse only have their whitespace normalized.
+func normalizeType(src string) string {
+	expr, err := goparser.ParseExpr(src)
+	if err != nil {
+		return strings.Join(strings.Fields(src), " ")
+	}
+	return exprString(expr)
+}
+
+// canonicalSignature renders the signature of m with opts. Signatures that don't parse
+// are returned with their whitespace normalized.
+func canonicalSignature(m *MethodDecl, opts RenderOptions) string {
+	sig := m.Sig
+	if sig == nil {
+		var err error
+		if sig, err = ParseSignature(m.Signature); err != nil {
+			return strings.Join(strings.Fields(m.Signature), " ")
+		}
+	}
+	return sig.Render(opts)
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// bodySkipper is a lexer that leaves out the contents of the bodies of the top-level
+// functions and methods, the parser sees func (t *T) M() {} and builds no tree for the
+// statements. The braces are matched at the token level, literals and comments are
+// single tokens so the braces they contain don't count.
+type bodySkipper struct {
+	antlr.Lexer
+	depth   int         // nesting of the parentheses, brackets and braces
+	inFunc  bool        // after a top-level func, before its body
+	prev    int         // type of the previous token of the default channel
+	pending antlr.Token // closing brace of the skipped body
+}
+
+// SkipBodies returns lexer without the contents of the function and method bodies, which
+// MethodListener doesn't need. The syntax errors inside the bodies are not reported.
+func SkipBodies(lexer antlr.Lexer) antlr.Lexer {
+	return &bodySkipper{Lexer: lexer}
+}
+
+// NextToken returns the next token of the lexer, the tokens of the bodies are skipped.
+func (s *bodySkipper) NextToken() antlr.Token {
+	if t := s.pending; t != nil {
+		s.pending = nil
+		s.prev = t.GetTokenType()
+		return t
+	}
+	t := s.Lexer.NextToken()
+	if t.GetChannel() != antlr.TokenDefaultChannel {
+		return t
+	}
+	switch t.GetTokenType() {
+	case GoLexerFUNC:
+		if s.depth == 0 {
+			s.inFunc = true
+		}
+	case GoLexerL_PAREN, GoLexerL_BRACKET:
+		s.depth++
+	case GoLexerR_PAREN, GoLexerR_BRACKET, GoLexerR_CURLY:
+		s.depth--
+	case GoLexerL_CURLY:
+		// the braces of struct and interface types in the signature are not the body
+		if s.inFunc && s.depth == 0 && s.prev != GoLexerSTRUCT && s.prev != GoLexerINTERFACE {
+			s.inFunc = false
+			s.pending = s.skipBody()
+		} else {
+			s.depth++
+		}
+	case GoLexerEOS, GoLexerSEMI, antlr.TokenEOF:
+		if s.depth == 0 {
+			s.inFunc = false // declaration without a body
+		}
+	}
+	s.prev = t.GetTokenType()
+	return t
+}
+
+// skipBody skips the tokens of a body up to its closing brace and returns it, or EOF if
+// the braces are unbalanced.
+func (s *bodySkipper) skipBody() antlr.Token {
+	depth := 1
+	for {
+		t := s.Lexer.NextToken()
+		switch t.GetTokenType() {
+		case GoLexerL_CURLY:
+			depth++
+		case GoLexerR_CURLY:
+			depth--
+			if depth == 0 {
+				return t
+			}
+		case antlr.TokenEOF:
+			return t
+		}
+	}
+}
@@ -0,0 +1,127 @@
+package parser
+
+import (
+	"go/build"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// benchSources returns the sources of the package go/ast, a package with large function
+// bodies, by file name.
+func benchSources(tb testing.TB) map[string]string {
+	tb.Helper()
+	dir := filepath.Join(build.Default.GOROOT, "src", "go", "ast")
+	names, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		tb.Fatal(err)
+	}
+	sources := map[string]string{}
+	for _, name := range names {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		src, err := os.ReadFile(name)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		sources[filepath.Base(name)] = string(src)
+	}
+	if len(sources) == 0 {
+		tb.Skipf("no sources in %s", dir)
+	}
+	return sources
+}
+
+// parseSource extracts the methods of src the way the antlr backend does, private methods
+// included, with or without the function bodies.
+func parseSource(filename, src string, skipBodies bool) *SourceFileInfo {
+	errListener := NewErrorListener(filename)
+	lexer := NewGoLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	var source antlr.Lexer = lexer
+	if skipBodies {
+		source = SkipBodies(lexer)
+	}
+	p := NewGoParser(antlr.NewCommonTokenStream(source, antlr.LexerDefaultTokenChannel))
+	p.BuildParseTrees = true
+	p.RemoveErrorListeners()
+	p.AddErrorListener(errListener)
+	tree := p.SourceFile()
+	if len(errListener.Diagnostics) != 0 {
+		return &SourceFileInfo{FileName: filename, Diagnostics: errListener.Diagnostics}
+	}
+	listener := NewMethodListener(true)
+	antlr.ParseTreeWalkerDefault.Walk(listener, tree)
+	fileInfo := listener.GetResult()
+	fileInfo.FileName = filename
+	return fileInfo
+}
+
+// checkSkipBodies fails unless skipping the bodies of sources extracts the same as the
+// full parse.
+func checkSkipBodies(tb testing.TB, sources map[string]string) {
+	tb.Helper()
+	for name, src := range sources {
+		full := parseSource(name, src, false)
+		if len(full.Diagnostics) != 0 {
+			tb.Fatalf("%s: %s", name, full.Diagnostics[0])
+		}
+		if skipped := parseSource(name, src, true); !reflect.DeepEqual(full, skipped) {
+			tb.Errorf("%s: skipping the bodies extracts another SourceFileInfo", name)
+		}
+	}
+}
+
+func TestSkipBodies(t *testing.T) {
+	sources := map[string]string{
+		"bodies.go": `package p
+
+type T struct{}
+
+// Get returns a value.
+func (t *T) Get(key string) (v struct{ n int }, err error) {
+	type local interface{ M() }
+	f := func() { _ = map[string]int{"}": 1} }
+	f()
+	/* } */
+	return
+}
+
+func (t T) Generic(fn func(int) interface{ Close() error }) {}
+
+func helper() { for {} }
+
+func (t *T) Last() {}
+`,
+	}
+	checkSkipBodies(t, sources)
+}
+
+// BenchmarkParse parses go/ast with and without the function bodies, once it checked
+// that both extract the same.
+func BenchmarkParse(b *testing.B) {
+	sources := benchSources(b)
+	checkSkipBodies(b, sources)
+	for _, bm := range []struct {
+		name       string
+		skipBodies bool
+	}{
+		{"Full", false},
+		{"SkipBodies", true},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				for name, src := range sources {
+					parseSource(name, src, bm.skipBodies)
+				}
+			}
+		})
+	}
+}
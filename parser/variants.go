@@ -0,0 +1,109 @@
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// MergeVariants merges the files of a package extracted under several build
+// configurations, names[i] being the name of variants[i], e.g. linux/amd64. The methods
+// every configuration has with the same signature, parameter names aside, are kept. The others are guarded, so
+// the generator emits them as comments, and reported as warnings.
+func MergeVariants(names []string, variants [][]*SourceFileInfo) ([]*SourceFileInfo, []*Diagnostic) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+
+	// method key -> signature -> configurations
+	type occurrence struct {
+		decl  *MethodDecl
+		file  *SourceFileInfo
+		names []string
+	}
+	found := map[string]map[string]*occurrence{}
+	for i, files := range variants {
+		for _, f := range files {
+			for _, m := range f.Methods {
+				key := m.Recv.StructType + "." + m.Identifier
+				signatures, ok := found[key]
+				if !ok {
+					signatures = map[string]*occurrence{}
+					found[key] = signatures
+				}
+				sig := canonicalSignature(m, StripNames)
+				o, ok := signatures[sig]
+				if !ok {
+					o = &occurrence{decl: m, file: f}
+					signatures[sig] = o
+				}
+				if len(o.names) == 0 || o.names[len(o.names)-1] != names[i] {
+					o.names = append(o.names, names[i])
+				}
+			}
+		}
+	}
+	common := func(m *MethodDecl) bool {
+		signatures := found[m.Recv.StructType+"."+m.Identifier]
+		o := signatures[canonicalSignature(m, StripNames)]
+		return len(signatures) == 1 && len(o.names) == len(variants)
+	}
+
+	// the files of the first configuration hold the common methods
+	var merged []*SourceFileInfo
+	for _, f := range variants[0] {
+		copied := *f
+		copied.Methods = nil
+		for _, m := range f.Methods {
+			if common(m) {
+				copied.Methods = append(copied.Methods, m)
+			}
+		}
+		merged = append(merged, &copied)
+	}
+
+	keys := make([]string, 0, len(found))
+	for key := range found {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	// the guarded methods are added in files of their own, without imports or types
+	var diagnostics []*Diagnostic
+	guardFiles := map[*SourceFileInfo]*SourceFileInfo{}
+	for _, key := range keys {
+		sigs := make([]string, 0, len(found[key]))
+		for sig := range found[key] {
+			sigs = append(sigs, sig)
+		}
+		sort.Strings(sigs)
+		for _, sig := range sigs {
+			o := found[key][sig]
+			if common(o.decl) {
+				continue
+			}
+			guarded := *o.decl
+			guarded.Guard = strings.Join(o.names, ", ")
+			g, ok := guardFiles[o.file]
+			if !ok {
+				g = &SourceFileInfo{FileName: o.file.FileName, PkgName: o.file.PkgName, PkgPath: o.file.PkgPath}
+				guardFiles[o.file] = g
+				merged = append(merged, g)
+			}
+			g.Methods = append(g.Methods, &guarded)
+
+			line := o.decl.Line
+			if line == 0 {
+				line = 1
+			}
+			diagnostics = append(diagnostics, &Diagnostic{
+				File:     o.file.FileName,
+				Line:     line,
+				Column:   1,
+				Message:  fmt.Sprintf("method %s is only available on %s", key, guarded.Guard),
+				Severity: SeverityWarning,
+			})
+		}
+	}
+	return merged, diagnostics
+}